@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"os"
@@ -23,16 +24,22 @@ func getTokenFname() string {
 }
 
 func main() {
-	// Acquire botAPIURL
-	APIURL, err := tgbot.LoadBotAPIURL(getTokenFname())
+	ctx := context.Background()
+
+	// Create bot
+	bot, err := tgbot.LoadBot(getTokenFname())
 	if err != nil {
 		log.Fatal(err)
 		os.Exit(1)
 	}
-	log.Print("TelegramBot API endpoint: " + APIURL)
+	log.Print("TelegramBot API endpoint: " + bot.APIURL)
+
+	// Wait out flood control instead of skipping messages
+	bot.RetryPolicy = tgbot.DefaultRetryPolicy()
+	bot.RetryPolicy.RetryNonIdempotent = true
 
 	// GetMe
-	user, _, err := tgbot.GetMe(APIURL)
+	user, _, err := bot.GetMe(ctx)
 	if err != nil {
 		log.Fatal(err)
 		os.Exit(1)
@@ -40,35 +47,32 @@ func main() {
 	marshaledUser, _ := json.Marshal(user)
 	log.Print("BotInfo: " + string(marshaledUser))
 
-	// Start Updates polling
-	lastUpdateID := tgbot.Integer(-1)
-	for true {
-		// poll Messages
-		updates, _, err := tgbot.GetUpdates(APIURL, tgbot.Params{
-			"offset":          lastUpdateID + 1,
-			"timeout":         15,
-			"allowed_updates": []string{"messages"}})
-		if err != nil {
-			log.Fatalln(err)
-			os.Exit(1)
+	// Echo text messages
+	dispatcher := tgbot.NewDispatcher()
+	dispatcher.Use(tgbot.Recover(log.Default()))
+	dispatcher.OnMessage(func(ctx context.Context, receivedMessage *tgbot.Message) error {
+		if receivedMessage.Text == nil {
+			return nil
 		}
-		log.Printf("received %v updates\n", len(updates))
-		for i := range updates {
-			lastUpdateID = updates[i].UpdateID
-			if updates[i].Message != nil && updates[i].Message.Text != nil {
-				receivedMessage := updates[i].Message
-				_, status, err := tgbot.SendMessage(APIURL, tgbot.Params{
-					"chat_id":             receivedMessage.Chat.ID,
-					"text":                "Echo " + *receivedMessage.Text,
-					"reply_to_message_id": receivedMessage.ID})
-				msgID, msgText := receivedMessage.ID, receivedMessage.Text
-				if status == 429 {
-					log.Printf("Skipped text message %v: \"%v\" due to rate limits\n", msgID, msgText)
-				} else if err != nil {
-					log.Fatalf("Failed to echo text message %v: %v\n\tReason: %v\n", msgID, msgText, err)
-					os.Exit(1)
-				}
-			}
+		_, _, err := bot.SendMessage(ctx, tgbot.Params{
+			"chat_id":             receivedMessage.Chat.ID,
+			"text":                "Echo " + *receivedMessage.Text,
+			"reply_to_message_id": receivedMessage.ID})
+		msgID, msgText := receivedMessage.ID, *receivedMessage.Text
+		if tgbot.IsFloodWait(err) {
+			log.Printf("Skipped text message %v: \"%v\" due to rate limits\n", msgID, msgText)
+		} else if err != nil {
+			log.Printf("Failed to echo text message %v: %v\n\tReason: %v\n", msgID, msgText, err)
 		}
+		return nil
+	})
+
+	// Start Updates polling
+	_, _, err = bot.PollUpdatesHandler(ctx, tgbot.Params{
+		"timeout":         15,
+		"allowed_updates": []string{"message"}}, dispatcher)
+	if err != nil {
+		log.Fatalln(err)
+		os.Exit(1)
 	}
 }
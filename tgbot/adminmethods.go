@@ -0,0 +1,89 @@
+package tgbot
+
+import (
+	"context"
+	"time"
+)
+
+// ChatMemberPermissions permissions of chat member, mirrors Can* fields of ChatMember.
+// Restricted member permissions are used by RestrictChatMember, administrator ones by PromoteChatMember.
+type ChatMemberPermissions struct {
+	// Administrator permissions
+	CanChangeInfo      bool `json:"can_change_info,omitempty"`      // Administrator can change the chat title, photo and other settings
+	CanPostMessages    bool `json:"can_post_messages,omitempty"`    // Administrator can post in the channel, channels only
+	CanEditMessages    bool `json:"can_edit_messages,omitempty"`    // Administrator can edit messages of other users, channels only
+	CanDeleteMessages  bool `json:"can_delete_messages,omitempty"`  // Administrator can delete messages of other users
+	CanInviteUsers     bool `json:"can_invite_users,omitempty"`     // Administrator can invite new users to the chat
+	CanRestrictMembers bool `json:"can_restrict_members,omitempty"` // Administrator can restrict, ban or unban chat members
+	CanPinMessages     bool `json:"can_pin_messages,omitempty"`     // Administrator can pin messages, supergroups only
+	CanPromoteMembers  bool `json:"can_promote_members,omitempty"`  // Administrator can add new administrators with a subset of own privileges
+
+	// Restricted member permissions
+	CanSendMessages       bool `json:"can_send_messages,omitempty"`         // User can send text messages, contacts, locations and venues
+	CanSendMediaMessages  bool `json:"can_send_media_messages,omitempty"`   // User can send audios, documents, photos, videos, video notes and voice notes, implies CanSendMessages
+	CanSendOtherMessages  bool `json:"can_send_other_messages,omitempty"`   // User can send animations, games, stickers and use inline bots, implies CanSendMediaMessages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"` // User may add web page previews to messages, implies CanSendMediaMessages
+}
+
+// adminParams Params of administrator permissions for promoteChatMember
+func (permissions ChatMemberPermissions) adminParams() Params {
+	return Params{
+		"can_change_info":      permissions.CanChangeInfo,
+		"can_post_messages":    permissions.CanPostMessages,
+		"can_edit_messages":    permissions.CanEditMessages,
+		"can_delete_messages":  permissions.CanDeleteMessages,
+		"can_invite_users":     permissions.CanInviteUsers,
+		"can_restrict_members": permissions.CanRestrictMembers,
+		"can_pin_messages":     permissions.CanPinMessages,
+		"can_promote_members":  permissions.CanPromoteMembers,
+	}
+}
+
+// restrictedParams Params of restricted member permissions for restrictChatMember
+func (permissions ChatMemberPermissions) restrictedParams() Params {
+	return Params{
+		"can_send_messages":         permissions.CanSendMessages,
+		"can_send_media_messages":   permissions.CanSendMediaMessages,
+		"can_send_other_messages":   permissions.CanSendOtherMessages,
+		"can_add_web_page_previews": permissions.CanAddWebPagePreviews,
+	}
+}
+
+// chatMemberParams common params of chat member management methods
+type chatMemberParams struct {
+	ChatID    interface{} `json:"chat_id"`
+	UserID    Integer     `json:"user_id"`
+	UntilDate time.Time   `json:"until_date,omitempty"`
+}
+
+// KickChatMember https://core.telegram.org/bots/api#kickchatmember
+// User is banned until untilDate, forever if it is zero or more than 366 days or less than 30 seconds from now.
+func (bot *Bot) KickChatMember(ctx context.Context, chatID interface{}, userID Integer, untilDate time.Time) (bool, int, error) {
+	return bot.callBool(ctx, "kickChatMember", paramsOf(chatMemberParams{ChatID: chatID, UserID: userID, UntilDate: untilDate}))
+}
+
+// UnbanChatMember https://core.telegram.org/bots/api#unbanchatmember
+func (bot *Bot) UnbanChatMember(ctx context.Context, chatID interface{}, userID Integer) (bool, int, error) {
+	return bot.callBool(ctx, "unbanChatMember", paramsOf(chatMemberParams{ChatID: chatID, UserID: userID}))
+}
+
+// RestrictChatMember https://core.telegram.org/bots/api#restrictchatmember
+// Only restricted member permissions are sent, all of them false restricts the user to read-only.
+// Restrictions are lifted at untilDate, never if it is zero or more than 366 days or less than 30 seconds from now.
+func (bot *Bot) RestrictChatMember(ctx context.Context, chatID interface{}, userID Integer, permissions ChatMemberPermissions, untilDate time.Time) (bool, int, error) {
+	params := paramsOf(chatMemberParams{ChatID: chatID, UserID: userID, UntilDate: untilDate})
+	for name, value := range permissions.restrictedParams() {
+		params[name] = value
+	}
+	return bot.callBool(ctx, "restrictChatMember", params)
+}
+
+// PromoteChatMember https://core.telegram.org/bots/api#promotechatmember
+// Only administrator permissions are sent, all of them false demotes the user.
+func (bot *Bot) PromoteChatMember(ctx context.Context, chatID interface{}, userID Integer, permissions ChatMemberPermissions) (bool, int, error) {
+	params := paramsOf(chatMemberParams{ChatID: chatID, UserID: userID})
+	for name, value := range permissions.adminParams() {
+		params[name] = value
+	}
+	return bot.callBool(ctx, "promoteChatMember", params)
+}
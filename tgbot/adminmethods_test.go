@@ -0,0 +1,83 @@
+package tgbot
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestAdminBot(t *testing.T, methodName string, expected map[string]string) *Bot {
+	return newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if !strings.HasSuffix(r.URL.Path, "/"+methodName) {
+			t.Errorf("Unexpected path: %v", r.URL.Path)
+		}
+		checkTestForm(t, r.PostForm, expected)
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+}
+
+func checkTestForm(t *testing.T, form url.Values, expected map[string]string) {
+	for key, value := range expected {
+		if form.Get(key) != value {
+			t.Errorf("Unexpected %v: %v", key, form.Get(key))
+		}
+	}
+	if len(form) != len(expected) {
+		t.Errorf("Unexpected form: %v", form)
+	}
+}
+
+func TestBotKickChatMember(t *testing.T) {
+	until := time.Unix(1500000000, 0)
+	bot := newTestAdminBot(t, "kickChatMember", map[string]string{"chat_id": "-100", "user_id": "7", "until_date": "1500000000"})
+	if ok, _, err := bot.KickChatMember(context.Background(), Integer(-100), 7, until); err != nil || !ok {
+		t.Fatal("bot.KickChatMember() failed: ", ok, err)
+	}
+
+	bot = newTestAdminBot(t, "kickChatMember", map[string]string{"chat_id": "@group", "user_id": "7"})
+	if ok, _, err := bot.KickChatMember(context.Background(), "@group", 7, time.Time{}); err != nil || !ok {
+		t.Fatal("bot.KickChatMember() forever failed: ", ok, err)
+	}
+}
+
+func TestBotRestrictChatMember(t *testing.T) {
+	bot := newTestAdminBot(t, "restrictChatMember", map[string]string{
+		"chat_id":                   "-100",
+		"user_id":                   "7",
+		"until_date":                "1500000060",
+		"can_send_messages":         "true",
+		"can_send_media_messages":   "false",
+		"can_send_other_messages":   "false",
+		"can_add_web_page_previews": "false",
+	})
+
+	permissions := ChatMemberPermissions{CanSendMessages: true, CanPinMessages: true}
+	ok, _, err := bot.RestrictChatMember(context.Background(), Integer(-100), 7, permissions, time.Unix(1500000060, 0))
+	if err != nil || !ok {
+		t.Fatal("bot.RestrictChatMember() failed: ", ok, err)
+	}
+}
+
+func TestBotPromoteChatMember(t *testing.T) {
+	bot := newTestAdminBot(t, "promoteChatMember", map[string]string{
+		"chat_id":              "-100",
+		"user_id":              "7",
+		"can_change_info":      "false",
+		"can_post_messages":    "false",
+		"can_edit_messages":    "false",
+		"can_delete_messages":  "true",
+		"can_invite_users":     "false",
+		"can_restrict_members": "true",
+		"can_pin_messages":     "true",
+		"can_promote_members":  "false",
+	})
+
+	permissions := ChatMemberPermissions{CanDeleteMessages: true, CanRestrictMembers: true, CanPinMessages: true, CanSendMessages: true}
+	if ok, _, err := bot.PromoteChatMember(context.Background(), Integer(-100), 7, permissions); err != nil || !ok {
+		t.Fatal("bot.PromoteChatMember() failed: ", ok, err)
+	}
+}
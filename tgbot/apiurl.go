@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 )
 
 // GenBotAPIURL Generate Telegram API URL from Bot token
@@ -12,14 +13,32 @@ func GenBotAPIURL(token string) string {
 	return fmt.Sprintf("https://api.telegram.org/bot%v/", token)
 }
 
-// LoadBotAPIURL Load Telegram Bot token and generate API URL
-func LoadBotAPIURL(fname string) (string, error) {
+// GenBotFileURL Generate Telegram file download URL from Bot token
+func GenBotFileURL(token string) string {
+	return fmt.Sprintf("https://api.telegram.org/file/bot%v/", token)
+}
+
+// LoadBotToken Load Telegram Bot token from the first line of file
+func LoadBotToken(fname string) (string, error) {
 	f, err := os.Open(fname)
 	if err != nil {
-		return "", errors.New("tgbotapi.LoadBotAPIURL: " + err.Error())
+		return "", fmt.Errorf("tgbotapi.LoadBotToken: %w", err)
 	}
 	defer f.Close()
 
 	token, _ := bufio.NewReader(f).ReadString('\n')
-	return GenBotAPIURL(token[:len(token)-1]), nil
+	token = strings.TrimSpace(token)
+	if len(token) == 0 {
+		return "", errors.New("tgbotapi.LoadBotToken: no token in " + fname)
+	}
+	return token, nil
+}
+
+// LoadBotAPIURL Load Telegram Bot token and generate API URL
+func LoadBotAPIURL(fname string) (string, error) {
+	token, err := LoadBotToken(fname)
+	if err != nil {
+		return "", fmt.Errorf("tgbotapi.LoadBotAPIURL: %w", err)
+	}
+	return GenBotAPIURL(token), nil
 }
@@ -0,0 +1,99 @@
+package tgbot
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Bot Telegram Bot API client, holds API URL, http.Client, logger and default params of a single bot.
+// Several Bots may be used concurrently in one process.
+type Bot struct {
+	APIURL     string       // Bot API endpoint with trailing slash, see GenBotAPIURL
+	FileURL    string       // File download endpoint with trailing slash, see GenBotFileURL
+	HTTPClient *http.Client // Client used for all requests. http.DefaultClient is used if nil
+
+	// Optional
+	Logger        *log.Logger  // Optional. Requests are logged here, nothing is logged if nil
+	DefaultParams Params       // Optional. Params added to every request unless the request sets them itself
+	RetryPolicy   *RetryPolicy // Optional. Failed requests are retried according to the policy, not retried if nil
+	Limiter       Limiter      // Optional. Requests with Params wait for the Limiter before being sent, e.g. NewChatLimiter()
+	OffsetStore   OffsetStore  // Optional. PollUpdates* load offset from it on start and save it after each handled batch (PollUpdatesHandler after each update)
+}
+
+// NewBot create Bot for token with its own http.Client
+func NewBot(token string) *Bot {
+	bot := NewBotWithAPIURL(GenBotAPIURL(token))
+	bot.FileURL = GenBotFileURL(token)
+	return bot
+}
+
+// NewBotWithAPIURL create Bot for custom API URL (self-hosted Bot API server, httptest.Server, etc.)
+// FileURL is derived from API URL of form <server>/bot<token>/ as <server>/file/bot<token>/, set it explicitly otherwise.
+func NewBotWithAPIURL(botAPIURL string) *Bot {
+	bot := &Bot{
+		APIURL:     botAPIURL,
+		HTTPClient: &http.Client{},
+		Logger:     log.Default(),
+	}
+	if i := strings.LastIndex(botAPIURL, "/bot"); i >= 0 && strings.HasSuffix(botAPIURL, "/") {
+		bot.FileURL = botAPIURL[:i] + "/file" + botAPIURL[i:]
+	}
+	return bot
+}
+
+// LoadBot Load Telegram Bot token and create Bot
+func LoadBot(fname string) (*Bot, error) {
+	token, err := LoadBotToken(fname)
+	if err != nil {
+		return nil, err
+	}
+	return NewBot(token), nil
+}
+
+// wrapBot Bot used by package-level functions that take botAPIURL
+func wrapBot(botAPIURL string) *Bot {
+	return &Bot{
+		APIURL:     botAPIURL,
+		HTTPClient: http.DefaultClient,
+		Logger:     log.Default(),
+	}
+}
+
+func (bot *Bot) httpClient() *http.Client {
+	if bot.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return bot.HTTPClient
+}
+
+// withDefaults merge DefaultParams into params, params take precedence
+func (bot *Bot) withDefaults(params Params) Params {
+	if len(bot.DefaultParams) == 0 {
+		return params
+	}
+
+	merged := Params{}
+	for key, value := range bot.DefaultParams {
+		merged[key] = value
+	}
+	for key, value := range params {
+		merged[key] = value
+	}
+	return merged
+}
+
+func (bot *Bot) logf(format string, v ...interface{}) {
+	if bot.Logger != nil {
+		bot.Logger.Printf(format, v...)
+	}
+}
+
+// wait for bot.Limiter to allow the request
+func (bot *Bot) wait(ctx context.Context, methodName string, params Params) error {
+	if bot.Limiter == nil {
+		return nil
+	}
+	return bot.Limiter.Wait(ctx, methodName, params)
+}
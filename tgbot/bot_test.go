@@ -0,0 +1,130 @@
+package tgbot
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testToken string = "123:TestToken"
+
+// newTestBot create Bot talking to httptest.Server with handler
+func newTestBot(t *testing.T, handler http.HandlerFunc) *Bot {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	bot := NewBotWithAPIURL(server.URL + "/bot" + testToken + "/")
+	bot.Logger = nil
+	return bot
+}
+
+func writeTestResponse(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	io.WriteString(w, body)
+}
+
+func TestNewBot(t *testing.T) {
+	bot := NewBot(testToken)
+	if bot.APIURL != GenBotAPIURL(testToken) {
+		t.Fatal("Unexpected bot.APIURL: " + bot.APIURL)
+	}
+
+	if bot.HTTPClient == nil || bot.HTTPClient == http.DefaultClient {
+		t.Fatal("NewBot should create its own http.Client")
+	}
+}
+
+func TestBotGetMe(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bot"+testToken+"/getMe" {
+			t.Errorf("Unexpected path: %v", r.URL.Path)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"id":123,"is_bot":true,"first_name":"Test"}}`)
+	})
+
+	user, status, err := bot.GetMe(context.Background())
+	if err != nil {
+		t.Fatal("bot.GetMe() failed: " + err.Error())
+	}
+
+	if status != 200 {
+		t.Fatalf("getMe status is %v (not 200 OK)\n", status)
+	}
+
+	if user.ID != 123 || !user.IsBot {
+		t.Fatalf("Unexpected user: %+v", user)
+	}
+}
+
+func TestBotDefaultParams(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("parse_mode") != "HTML" || query.Get("text") != "hi" || query.Get("chat_id") != "42" {
+			t.Errorf("Unexpected query: %v", r.URL.RawQuery)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":42,"type":"private"}}}`)
+	})
+	bot.DefaultParams = Params{"parse_mode": "HTML", "chat_id": 1}
+
+	message, _, err := bot.SendMessage(context.Background(), Params{"chat_id": 42, "text": "hi"})
+	if err != nil {
+		t.Fatal("bot.SendMessage() failed: " + err.Error())
+	}
+	if message.ID != 1 {
+		t.Fatalf("Unexpected message id: %v", message.ID)
+	}
+}
+
+func TestBotNotOk(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 404, `{"ok":false,"error_code":404,"description":"Not Found"}`)
+	})
+
+	response, status, err := bot.Get(context.Background(), "getMe", Params{})
+	if err != nil {
+		t.Fatal("bot.Get() failed: " + err.Error())
+	}
+
+	if status != 404 || response.Ok {
+		t.Fatalf("Unexpected response: %v %+v", status, response)
+	}
+
+	if _, _, err = bot.GetMe(context.Background()); err == nil {
+		t.Fatal("bot.GetMe() should've failed")
+	}
+}
+
+func TestBotConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	bot := NewBotWithAPIURL(server.URL + "/bot" + testToken + "/")
+	bot.Logger = nil
+	_, status, err := bot.GetMe(context.Background())
+	if err == nil || status != 0 {
+		t.Fatal("bot.GetMe() should've failed without status")
+	}
+}
+
+func TestBotsAreIndependent(t *testing.T) {
+	newBot := func(name string) *Bot {
+		return newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+			writeTestResponse(w, 200, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"`+name+`"}}`)
+		})
+	}
+	first, second := newBot("first"), newBot("second")
+
+	for _, bot := range []*Bot{first, second, first} {
+		user, _, err := bot.GetMe(context.Background())
+		if err != nil {
+			t.Fatal("bot.GetMe() failed: " + err.Error())
+		}
+		if !strings.HasPrefix(bot.APIURL, "http://") || (bot == first) != (user.FirstName == "first") {
+			t.Fatalf("Bot got response of another bot: %v", user.FirstName)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// AnswerCallbackQueryParams https://core.telegram.org/bots/api#answercallbackquery
+type AnswerCallbackQueryParams struct {
+	CallbackQueryID string `json:"callback_query_id"` // Unique identifier for the query to be answered
+
+	// Optional
+	Text      string  `json:"text,omitempty"`       // Optional. Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
+	ShowAlert bool    `json:"show_alert,omitempty"` // Optional. If true, an alert will be shown by the client instead of a notification at the top of the chat screen
+	URL       string  `json:"url,omitempty"`        // Optional. URL that will be opened by the user's client: game URL or t.me/your_bot?start=XXXX link
+	CacheTime Integer `json:"cache_time,omitempty"` // Optional. The maximum amount of time in seconds that the result of the callback query may be cached client-side
+}
+
+// AnswerCallbackQuery https://core.telegram.org/bots/api#answercallbackquery
+func (bot *Bot) AnswerCallbackQuery(ctx context.Context, params AnswerCallbackQueryParams) (bool, int, error) {
+	return bot.callBool(ctx, "answerCallbackQuery", paramsOf(params))
+}
+
+// CallbackSeparator separator of callback data segments matched by CallbackRouter, e.g. "vote:42:yes"
+const CallbackSeparator = ":"
+
+// Callback callback query matched by CallbackRouter
+type Callback struct {
+	Query  *CallbackQuery    // Matched callback query
+	Data   string            // Query data, or game short name of game query
+	Params map[string]string // Segments captured by <name> placeholders of the pattern
+	Args   []string          // Segments of data after the matched prefix, see CallbackRouter.HandlePrefix
+
+	bot      *Bot
+	answered bool
+}
+
+// Param segment of data captured by <name> placeholder, empty if there is no such placeholder
+func (callback *Callback) Param(name string) string {
+	return callback.Params[name]
+}
+
+// Answer answer query with notification text or alert, CallbackRouter doesn't answer it after handler then
+func (callback *Callback) Answer(ctx context.Context, text string, showAlert bool) error {
+	return callback.AnswerWith(ctx, AnswerCallbackQueryParams{Text: text, ShowAlert: showAlert})
+}
+
+// AnswerWith answer query with params, CallbackQueryID is set from Query
+func (callback *Callback) AnswerWith(ctx context.Context, params AnswerCallbackQueryParams) error {
+	params.CallbackQueryID = callback.Query.ID
+	if _, _, err := callback.bot.AnswerCallbackQuery(ctx, params); err != nil {
+		return err
+	}
+	callback.answered = true
+	return nil
+}
+
+// AnswerGame answer game query with gameURL, so the client opens the game, see Bot.AnswerGameCallback
+func (callback *Callback) AnswerGame(ctx context.Context, gameURL string) error {
+	if _, _, err := callback.bot.AnswerGameCallback(ctx, callback.Query, gameURL); err != nil {
+		return err
+	}
+	callback.answered = true
+	return nil
+}
+
+// CallbackHandlerFunc handler of callback queries matched by CallbackRouter
+type CallbackHandlerFunc func(ctx context.Context, callback *Callback) error
+
+type callbackRoute struct {
+	segments []string // pattern segments, <name> captures a segment
+	prefix   bool     // match data starting with segments
+	handler  CallbackHandlerFunc
+}
+
+// CallbackRouter routes callback queries to handlers by data, segments separated by CallbackSeparator.
+// Routes are matched in order of registration, game queries are matched by game short name. Queries not answered
+// by handler with Callback methods are answered with empty answer, so clients stop showing progress.
+// Use HandleCallbackQuery as Dispatcher.OnCallbackQuery handler.
+type CallbackRouter struct {
+	Bot *Bot // Bot used to answer queries
+
+	mutex  sync.RWMutex
+	routes []callbackRoute
+}
+
+// NewCallbackRouter create CallbackRouter answering queries with bot
+func NewCallbackRouter(bot *Bot) *CallbackRouter {
+	return &CallbackRouter{Bot: bot}
+}
+
+// Handle register handler of data matching pattern: literal segments must be equal, <name> segments are captured into Params.
+// E.g. "vote:<id>:<choice>" matches "vote:42:yes" with Params {"id": "42", "choice": "yes"}.
+func (router *CallbackRouter) Handle(pattern string, handler CallbackHandlerFunc) {
+	router.add(callbackRoute{segments: strings.Split(pattern, CallbackSeparator), handler: handler})
+}
+
+// HandlePrefix register handler of data starting with prefix segments (which may have <name> placeholders too),
+// the rest segments are passed as Args. E.g. prefix "page" matches "page:3" with Args ["3"] and "page" with no Args.
+func (router *CallbackRouter) HandlePrefix(prefix string, handler CallbackHandlerFunc) {
+	router.add(callbackRoute{segments: strings.Split(prefix, CallbackSeparator), prefix: true, handler: handler})
+}
+
+func (router *CallbackRouter) add(route callbackRoute) {
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+	router.routes = append(router.routes, route)
+}
+
+// HandleCallbackQuery CallbackQueryHandlerFunc dispatching queries to the first matching handler.
+// Returns ErrStopPropagation after a query is handled, nil for queries without data and unmatched queries.
+func (router *CallbackRouter) HandleCallbackQuery(ctx context.Context, query *CallbackQuery) error {
+	data := query.Data
+	if data == nil {
+		data = query.GameShortName
+	}
+	if data == nil {
+		return nil
+	}
+
+	callback, handler := router.match(*data)
+	if handler == nil {
+		return nil
+	}
+	callback.Query = query
+	callback.bot = router.Bot
+
+	err := handler(ctx, callback)
+	if !callback.answered {
+		if answerErr := callback.AnswerWith(ctx, AnswerCallbackQueryParams{}); answerErr != nil && err == nil {
+			err = answerErr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("tgbot.CallbackRouter: %v: %w", callback.Data, err)
+	}
+	return ErrStopPropagation
+}
+
+func (router *CallbackRouter) match(data string) (*Callback, CallbackHandlerFunc) {
+	segments := strings.Split(data, CallbackSeparator)
+
+	router.mutex.RLock()
+	defer router.mutex.RUnlock()
+
+	for _, route := range router.routes {
+		if len(segments) < len(route.segments) || (!route.prefix && len(segments) != len(route.segments)) {
+			continue
+		}
+
+		callback := &Callback{Data: data, Params: map[string]string{}}
+		matched := true
+		for i, segment := range route.segments {
+			if strings.HasPrefix(segment, "<") && strings.HasSuffix(segment, ">") {
+				callback.Params[segment[1:len(segment)-1]] = segments[i]
+			} else if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			callback.Args = segments[len(route.segments):]
+			return callback, route.handler
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+func newTestCallbackBot(t *testing.T) (*Bot, func() []url.Values) {
+	var mutex sync.Mutex
+	var answers []url.Values
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		mutex.Lock()
+		answers = append(answers, r.PostForm)
+		mutex.Unlock()
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+	return bot, func() []url.Values {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return answers
+	}
+}
+
+func newTestCallbackQuery(data string) *CallbackQuery {
+	return &CallbackQuery{ID: "cq1", From: User{ID: 7}, ChatInstance: "1", Data: &data}
+}
+
+func TestCallbackRouterMatch(t *testing.T) {
+	bot, _ := newTestCallbackBot(t)
+	router := NewCallbackRouter(bot)
+
+	var matched []string
+	router.Handle("vote:<id>:<choice>", func(ctx context.Context, callback *Callback) error {
+		matched = append(matched, "vote "+callback.Param("id")+" "+callback.Param("choice"))
+		return nil
+	})
+	router.HandlePrefix("page", func(ctx context.Context, callback *Callback) error {
+		matched = append(matched, fmt.Sprint("page ", callback.Args))
+		return nil
+	})
+
+	for _, data := range []string{"vote:42:yes", "page:3", "page", "vote:42", "other"} {
+		err := router.HandleCallbackQuery(context.Background(), newTestCallbackQuery(data))
+		if err != nil && err != ErrStopPropagation {
+			t.Fatal("router.HandleCallbackQuery() failed: " + err.Error())
+		}
+	}
+
+	if fmt.Sprint(matched) != "[vote 42 yes page [3] page []]" {
+		t.Fatalf("Unexpected matches %v", matched)
+	}
+}
+
+func TestCallbackRouterAutoAnswer(t *testing.T) {
+	bot, answers := newTestCallbackBot(t)
+	router := NewCallbackRouter(bot)
+	router.Handle("silent", func(ctx context.Context, callback *Callback) error {
+		return nil
+	})
+	router.Handle("alert", func(ctx context.Context, callback *Callback) error {
+		return callback.Answer(ctx, "Done", true)
+	})
+	router.Handle("fail", func(ctx context.Context, callback *Callback) error {
+		return errors.New("handler failed")
+	})
+
+	for _, data := range []string{"silent", "alert", "fail"} {
+		router.HandleCallbackQuery(context.Background(), newTestCallbackQuery(data))
+	}
+	if err := router.HandleCallbackQuery(context.Background(), newTestCallbackQuery("unknown")); err != nil {
+		t.Fatal("Unmatched query must pass through, got ", err)
+	}
+
+	received := answers()
+	if len(received) != 3 {
+		t.Fatalf("Expected 3 answers, got %v", received)
+	}
+	if received[0].Get("callback_query_id") != "cq1" || received[0].Get("text") != "" {
+		t.Fatalf("Unexpected auto answer: %v", received[0])
+	}
+	if received[1].Get("text") != "Done" || received[1].Get("show_alert") != "true" {
+		t.Fatalf("Unexpected answer: %v", received[1])
+	}
+}
+
+func TestCallbackRouterAnswerGame(t *testing.T) {
+	bot, answers := newTestCallbackBot(t)
+	router := NewCallbackRouter(bot)
+	router.Handle("tetris", func(ctx context.Context, callback *Callback) error {
+		return callback.AnswerGame(ctx, "https://example.com/tetris")
+	})
+
+	gameShortName := "tetris"
+	query := &CallbackQuery{ID: "cq1", From: User{ID: 7}, ChatInstance: "1", GameShortName: &gameShortName}
+	if err := router.HandleCallbackQuery(context.Background(), query); err != ErrStopPropagation {
+		t.Fatal("Game query should be handled, got ", err)
+	}
+
+	received := answers()
+	if len(received) != 1 {
+		t.Fatalf("Game query should be answered once, got %v", received)
+	}
+	if received[0].Get("url") != "https://example.com/tetris" {
+		t.Fatalf("Unexpected answer: %v", received[0])
+	}
+}
+
+func TestBotAnswerCallbackQuery(t *testing.T) {
+	bot, answers := newTestCallbackBot(t)
+
+	ok, _, err := bot.AnswerCallbackQuery(context.Background(), AnswerCallbackQueryParams{
+		CallbackQueryID: "cq1",
+		URL:             "t.me/testbot?start=xyz",
+		CacheTime:       60,
+	})
+	if err != nil || !ok {
+		t.Fatal("bot.AnswerCallbackQuery() failed: ", ok, err)
+	}
+
+	answer := answers()[0]
+	if answer.Get("url") != "t.me/testbot?start=xyz" || answer.Get("cache_time") != "60" || len(answer) != 3 {
+		t.Fatalf("Unexpected answer: %v", answer)
+	}
+}
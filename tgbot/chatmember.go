@@ -0,0 +1,212 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ChatMember statuses https://core.telegram.org/bots/api#chatmember
+const (
+	StatusCreator       = "creator"
+	StatusAdministrator = "administrator"
+	StatusMember        = "member"
+	StatusRestricted    = "restricted"
+	StatusLeft          = "left"
+	StatusKicked        = "kicked"
+)
+
+// IsAdmin true if member is the chat creator or administrator
+func (member *ChatMember) IsAdmin() bool {
+	return member.Status == StatusCreator || member.Status == StatusAdministrator
+}
+
+// IsMember true if user is currently in the chat: creator, administrator, member or restricted
+func (member *ChatMember) IsMember() bool {
+	return member.IsAdmin() || member.Status == StatusMember || member.Status == StatusRestricted
+}
+
+// Until date when restrictions or ban are lifted, zero if never
+func (member *ChatMember) Until() time.Time {
+	if member.UntilDate == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(member.UntilDate), 0)
+}
+
+// Permissions effective permissions of member: creator can do everything, administrators have their Can* rights
+// and send messages freely, members send messages freely, restricted members have their Can* rights,
+// users who left or were kicked have no permissions.
+func (member *ChatMember) Permissions() ChatMemberPermissions {
+	allowed := func(flag *bool) bool { return flag != nil && *flag }
+	sendAll := ChatMemberPermissions{CanSendMessages: true, CanSendMediaMessages: true, CanSendOtherMessages: true, CanAddWebPagePreviews: true}
+
+	switch member.Status {
+	case StatusCreator:
+		permissions := sendAll
+		permissions.CanChangeInfo = true
+		permissions.CanPostMessages = true
+		permissions.CanEditMessages = true
+		permissions.CanDeleteMessages = true
+		permissions.CanInviteUsers = true
+		permissions.CanRestrictMembers = true
+		permissions.CanPinMessages = true
+		permissions.CanPromoteMembers = true
+		return permissions
+	case StatusAdministrator:
+		permissions := sendAll
+		permissions.CanChangeInfo = allowed(member.CanChangeInfo)
+		permissions.CanPostMessages = allowed(member.CanPostMessages)
+		permissions.CanEditMessages = allowed(member.CanEditMessages)
+		permissions.CanDeleteMessages = allowed(member.CanDeleteMessages)
+		permissions.CanInviteUsers = allowed(member.CanInviteUsers)
+		permissions.CanRestrictMembers = allowed(member.CanRestrictMembers)
+		permissions.CanPinMessages = allowed(member.CanPinMessages)
+		permissions.CanPromoteMembers = allowed(member.CanPromoteMembers)
+		return permissions
+	case StatusMember:
+		return sendAll
+	case StatusRestricted:
+		permissions := ChatMemberPermissions{CanSendMessages: allowed(member.CanSendMessages)}
+		permissions.CanSendMediaMessages = permissions.CanSendMessages && allowed(member.CanSendMediaMessages)
+		permissions.CanSendOtherMessages = permissions.CanSendMediaMessages && allowed(member.CanSendOtherMessages)
+		permissions.CanAddWebPagePreviews = permissions.CanSendMediaMessages && allowed(member.CanAddWebPagePreviews)
+		return permissions
+	}
+	return ChatMemberPermissions{}
+}
+
+// DefaultAdminCacheTTL time AdminCache keeps administrators of a chat
+const DefaultAdminCacheTTL = time.Minute
+
+// AdminCache caches getChatAdministrators results per chat for TTL,
+// so permission checks in busy groups don't call Bot API on every message.
+// Concurrent misses of the same chat share one request.
+type AdminCache struct {
+	Bot *Bot          // Bot used to get administrators
+	TTL time.Duration // Time administrators are cached, DefaultAdminCacheTTL if 0
+
+	mutex   sync.Mutex
+	entries map[string]adminCacheEntry
+	fetches map[string]*adminCacheFetch // requests in progress by chat
+	clock   clock                       // replaced in tests
+}
+
+type adminCacheEntry struct {
+	administrators []ChatMember
+	expires        time.Time
+}
+
+// adminCacheFetch getChatAdministrators request other callers wait for, result is set before done is closed
+type adminCacheFetch struct {
+	done           chan struct{}
+	administrators []ChatMember
+	err            error
+}
+
+// NewAdminCache create AdminCache getting administrators with bot
+func NewAdminCache(bot *Bot, ttl time.Duration) *AdminCache {
+	return &AdminCache{Bot: bot, TTL: ttl}
+}
+
+// Administrators administrators of chat, cached for TTL. Returned slice is a copy, the cache is not affected by its changes.
+func (cache *AdminCache) Administrators(ctx context.Context, chatID interface{}) ([]ChatMember, error) {
+	administrators, err := cache.administrators(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]ChatMember(nil), administrators...), nil
+}
+
+// administrators cached administrators of chat, must not be modified
+func (cache *AdminCache) administrators(ctx context.Context, chatID interface{}) ([]ChatMember, error) {
+	key := fmt.Sprint(chatID)
+	now := cache.getClock().Now()
+
+	cache.mutex.Lock()
+	if entry, ok := cache.entries[key]; ok && now.Before(entry.expires) {
+		cache.mutex.Unlock()
+		return entry.administrators, nil
+	}
+	if fetch, ok := cache.fetches[key]; ok {
+		cache.mutex.Unlock()
+		select {
+		case <-fetch.done:
+			return fetch.administrators, fetch.err
+		case <-ctx.Done():
+			return nil, fmt.Errorf("tgbot.AdminCache: %w", ctx.Err())
+		}
+	}
+	if cache.fetches == nil {
+		cache.fetches = map[string]*adminCacheFetch{}
+	}
+	fetch := &adminCacheFetch{done: make(chan struct{})}
+	cache.fetches[key] = fetch
+	cache.mutex.Unlock()
+
+	defer close(fetch.done)
+	fetch.administrators, fetch.err = cache.fetch(ctx, key, chatID, now)
+	return fetch.administrators, fetch.err
+}
+
+// fetch get administrators of chat and cache them, the fetch of key is finished
+func (cache *AdminCache) fetch(ctx context.Context, key string, chatID interface{}, now time.Time) ([]ChatMember, error) {
+	administrators, _, err := cache.Bot.GetChatAdministrators(ctx, chatID)
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	delete(cache.fetches, key)
+	if err != nil {
+		return nil, fmt.Errorf("tgbot.AdminCache: %w", err)
+	}
+
+	ttl := cache.TTL
+	if ttl <= 0 {
+		ttl = DefaultAdminCacheTTL
+	}
+	if cache.entries == nil {
+		cache.entries = map[string]adminCacheEntry{}
+	}
+	for key, entry := range cache.entries {
+		if !now.Before(entry.expires) {
+			delete(cache.entries, key)
+		}
+	}
+	cache.entries[key] = adminCacheEntry{administrators: administrators, expires: now.Add(ttl)}
+	return administrators, nil
+}
+
+func (cache *AdminCache) getClock() clock {
+	if cache.clock == nil {
+		return realClock{}
+	}
+	return cache.clock
+}
+
+// Administrator administrator of chat with userID, false if user is not an administrator
+func (cache *AdminCache) Administrator(ctx context.Context, chatID interface{}, userID Integer) (*ChatMember, bool, error) {
+	administrators, err := cache.administrators(ctx, chatID)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, administrator := range administrators {
+		if administrator.User.ID == userID {
+			return &administrator, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// IsAdmin true if user with userID is administrator or creator of chat
+func (cache *AdminCache) IsAdmin(ctx context.Context, chatID interface{}, userID Integer) (bool, error) {
+	_, ok, err := cache.Administrator(ctx, chatID, userID)
+	return ok, err
+}
+
+// Invalidate drop cached administrators of chat, e.g. after PromoteChatMember
+func (cache *AdminCache) Invalidate(chatID interface{}) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	delete(cache.entries, fmt.Sprint(chatID))
+}
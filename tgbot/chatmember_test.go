@@ -0,0 +1,123 @@
+package tgbot
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestChatMemberPermissions(t *testing.T) {
+	yes, no := true, false
+	admin := &ChatMember{Status: StatusAdministrator, CanDeleteMessages: &yes, CanPinMessages: &no}
+	if !admin.IsAdmin() || !admin.IsMember() {
+		t.Fatal("Administrator is not admin member")
+	}
+	permissions := admin.Permissions()
+	if !permissions.CanDeleteMessages || permissions.CanPinMessages || permissions.CanPromoteMembers || !permissions.CanSendMessages {
+		t.Fatalf("Unexpected administrator permissions: %+v", permissions)
+	}
+
+	if permissions := (&ChatMember{Status: StatusCreator}).Permissions(); !permissions.CanPromoteMembers || !permissions.CanSendOtherMessages {
+		t.Fatalf("Unexpected creator permissions: %+v", permissions)
+	}
+
+	kicked := &ChatMember{Status: StatusKicked}
+	if kicked.IsMember() || kicked.Permissions() != (ChatMemberPermissions{}) || !kicked.Until().IsZero() {
+		t.Fatalf("Unexpected kicked member: %+v", kicked.Permissions())
+	}
+}
+
+func TestAdminCache(t *testing.T) {
+	var calls int32
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeTestResponse(w, 200, `{"ok":true,"result":[
+			{"user":{"id":1,"is_bot":false,"first_name":"Owner"},"status":"creator"},
+			{"user":{"id":2,"is_bot":false,"first_name":"Admin"},"status":"administrator","can_delete_messages":true}]}`)
+	})
+	clock := newFakeClock()
+	cache := NewAdminCache(bot, time.Minute)
+	cache.clock = clock
+	ctx := context.Background()
+
+	for _, userID := range []Integer{1, 2, 3} {
+		isAdmin, err := cache.IsAdmin(ctx, Integer(-100), userID)
+		if err != nil {
+			t.Fatal("cache.IsAdmin() failed: " + err.Error())
+		}
+		if isAdmin != (userID != 3) {
+			t.Fatalf("Unexpected IsAdmin of %v: %v", userID, isAdmin)
+		}
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("Administrators are not cached, %v calls", calls)
+	}
+
+	admin, ok, _ := cache.Administrator(ctx, Integer(-100), 2)
+	if !ok || !admin.Permissions().CanDeleteMessages {
+		t.Fatalf("Unexpected administrator: %+v", admin)
+	}
+
+	clock.Sleep(ctx, 2*time.Minute)
+	cache.IsAdmin(ctx, Integer(-100), 1)
+	cache.Invalidate(Integer(-100))
+	cache.IsAdmin(ctx, Integer(-100), 1)
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("Expired or invalidated administrators are not refreshed, %v calls", calls)
+	}
+}
+
+func TestAdminCacheCopy(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 200, `{"ok":true,"result":[{"user":{"id":1,"is_bot":false,"first_name":"Owner"},"status":"creator"}]}`)
+	})
+	cache := NewAdminCache(bot, time.Minute)
+	ctx := context.Background()
+
+	administrators, _ := cache.Administrators(ctx, Integer(-100))
+	administrators[0].Status = StatusLeft
+	admin, _, _ := cache.Administrator(ctx, Integer(-100), 1)
+	admin.Status = StatusKicked
+
+	if administrators, _ = cache.Administrators(ctx, Integer(-100)); administrators[0].Status != StatusCreator {
+		t.Fatalf("Cached administrators were modified by caller: %+v", administrators[0])
+	}
+}
+
+func TestAdminCacheConcurrentMisses(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		writeTestResponse(w, 200, `{"ok":true,"result":[{"user":{"id":1,"is_bot":false,"first_name":"Owner"},"status":"creator"}]}`)
+	})
+	cache := NewAdminCache(bot, time.Minute)
+
+	var wg sync.WaitGroup
+	results := make(chan bool, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			isAdmin, err := cache.IsAdmin(context.Background(), Integer(-100), 1)
+			results <- isAdmin && err == nil
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	for ok := range results {
+		if !ok {
+			t.Fatal("Waiting caller didn't get administrators")
+		}
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("Concurrent misses should share one request, %v calls", calls)
+	}
+}
@@ -0,0 +1,130 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// getResult call method with GET and unmarshal its result into v
+func (bot *Bot) getResult(ctx context.Context, methodName string, params Params, v interface{}) (int, error) {
+	response, status, err := bot.Get(ctx, methodName, params)
+	if err != nil {
+		return status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	if err = response.GetResult(v); err != nil {
+		return status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	return status, nil
+}
+
+// GetChat https://core.telegram.org/bots/api#getchat
+func (bot *Bot) GetChat(ctx context.Context, chatID interface{}) (*Chat, int, error) {
+	chat := &Chat{}
+	status, err := bot.getResult(ctx, "getChat", Params{"chat_id": chatID}, chat)
+	if err != nil {
+		return nil, status, err
+	}
+	return chat, status, nil
+}
+
+// GetChatAdministrators https://core.telegram.org/bots/api#getchatadministrators
+// Other bots are not returned, see AdminCache to avoid calling it on every message.
+func (bot *Bot) GetChatAdministrators(ctx context.Context, chatID interface{}) ([]ChatMember, int, error) {
+	var administrators []ChatMember
+	status, err := bot.getResult(ctx, "getChatAdministrators", Params{"chat_id": chatID}, &administrators)
+	if err != nil {
+		return nil, status, err
+	}
+	return administrators, status, nil
+}
+
+// GetChatMembersCount https://core.telegram.org/bots/api#getchatmemberscount
+func (bot *Bot) GetChatMembersCount(ctx context.Context, chatID interface{}) (Integer, int, error) {
+	var count Integer
+	status, err := bot.getResult(ctx, "getChatMembersCount", Params{"chat_id": chatID}, &count)
+	return count, status, err
+}
+
+// GetChatMember https://core.telegram.org/bots/api#getchatmember
+func (bot *Bot) GetChatMember(ctx context.Context, chatID interface{}, userID Integer) (*ChatMember, int, error) {
+	member := &ChatMember{}
+	status, err := bot.getResult(ctx, "getChatMember", Params{"chat_id": chatID, "user_id": userID}, member)
+	if err != nil {
+		return nil, status, err
+	}
+	return member, status, nil
+}
+
+// SetChatTitle https://core.telegram.org/bots/api#setchattitle
+func (bot *Bot) SetChatTitle(ctx context.Context, chatID interface{}, title string) (bool, int, error) {
+	return bot.callBool(ctx, "setChatTitle", Params{"chat_id": chatID, "title": title})
+}
+
+// SetChatDescription https://core.telegram.org/bots/api#setchatdescription
+// Empty description removes the current one.
+func (bot *Bot) SetChatDescription(ctx context.Context, chatID interface{}, description string) (bool, int, error) {
+	return bot.callBool(ctx, "setChatDescription", Params{"chat_id": chatID, "description": description})
+}
+
+// SetChatPhoto https://core.telegram.org/bots/api#setchatphoto
+// photo must be an upload, photos can't be set by file_id or URL
+func (bot *Bot) SetChatPhoto(ctx context.Context, chatID interface{}, photo *InputFile) (bool, int, error) {
+	if photo == nil || !photo.IsUpload() {
+		return false, 0, errors.New("tgbot.setChatPhoto: photo must be an upload")
+	}
+
+	response, status, err := bot.PostMultipartForm(ctx, "setChatPhoto", Params{"chat_id": chatID, "photo": photo})
+	if err != nil {
+		return false, status, fmt.Errorf("tgbot.setChatPhoto: %w", err)
+	}
+
+	result, err := response.GetResultBool()
+	if err != nil {
+		return false, status, fmt.Errorf("tgbot.setChatPhoto: %w", err)
+	}
+
+	return result, status, nil
+}
+
+// DeleteChatPhoto https://core.telegram.org/bots/api#deletechatphoto
+func (bot *Bot) DeleteChatPhoto(ctx context.Context, chatID interface{}) (bool, int, error) {
+	return bot.callBool(ctx, "deleteChatPhoto", Params{"chat_id": chatID})
+}
+
+// PinChatMessage https://core.telegram.org/bots/api#pinchatmessage
+func (bot *Bot) PinChatMessage(ctx context.Context, chatID interface{}, messageID Integer, disableNotification bool) (bool, int, error) {
+	params := Params{"chat_id": chatID, "message_id": messageID}
+	if disableNotification {
+		params["disable_notification"] = true
+	}
+	return bot.callBool(ctx, "pinChatMessage", params)
+}
+
+// UnpinChatMessage https://core.telegram.org/bots/api#unpinchatmessage
+func (bot *Bot) UnpinChatMessage(ctx context.Context, chatID interface{}) (bool, int, error) {
+	return bot.callBool(ctx, "unpinChatMessage", Params{"chat_id": chatID})
+}
+
+// ExportChatInviteLink https://core.telegram.org/bots/api#exportchatinvitelink
+// Previous invite link is revoked, the new one is returned.
+func (bot *Bot) ExportChatInviteLink(ctx context.Context, chatID interface{}) (string, int, error) {
+	response, status, err := bot.Call(ctx, "exportChatInviteLink", Params{"chat_id": chatID})
+	if err != nil {
+		return "", status, fmt.Errorf("tgbot.exportChatInviteLink: %w", err)
+	}
+
+	var inviteLink string
+	if err = response.GetResult(&inviteLink); err != nil {
+		return "", status, fmt.Errorf("tgbot.exportChatInviteLink: %w", err)
+	}
+
+	return inviteLink, status, nil
+}
+
+// LeaveChat https://core.telegram.org/bots/api#leavechat
+func (bot *Bot) LeaveChat(ctx context.Context, chatID interface{}) (bool, int, error) {
+	return bot.callBool(ctx, "leaveChat", Params{"chat_id": chatID})
+}
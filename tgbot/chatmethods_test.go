@@ -0,0 +1,119 @@
+package tgbot
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBotGetChat(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/getChat") || r.URL.Query().Get("chat_id") != "@group" {
+			t.Errorf("Unexpected request: %v", r.URL)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"id":-100,"type":"supergroup","title":"Group","description":"About"}}`)
+	})
+
+	chat, _, err := bot.GetChat(context.Background(), "@group")
+	if err != nil {
+		t.Fatal("bot.GetChat() failed: " + err.Error())
+	}
+	if chat.ID != -100 || *chat.Title != "Group" || *chat.Description != "About" {
+		t.Fatalf("Unexpected chat: %+v", chat)
+	}
+}
+
+func TestBotGetChatMembersCount(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 200, `{"ok":true,"result":42}`)
+	})
+
+	count, _, err := bot.GetChatMembersCount(context.Background(), Integer(-100))
+	if err != nil || count != 42 {
+		t.Fatal("bot.GetChatMembersCount() failed: ", count, err)
+	}
+}
+
+func TestBotGetChatMember(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("chat_id") != "-100" || r.URL.Query().Get("user_id") != "7" {
+			t.Errorf("Unexpected request: %v", r.URL)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"user":{"id":7,"is_bot":false,"first_name":"A"},"status":"restricted","until_date":1500000000,
+			"can_send_messages":true,"can_send_media_messages":false,"can_send_other_messages":true,"can_add_web_page_previews":true}}`)
+	})
+
+	member, _, err := bot.GetChatMember(context.Background(), Integer(-100), 7)
+	if err != nil {
+		t.Fatal("bot.GetChatMember() failed: " + err.Error())
+	}
+	if member.IsAdmin() || !member.IsMember() || member.Until().Unix() != 1500000000 {
+		t.Fatalf("Unexpected member: %+v", member)
+	}
+	expected := ChatMemberPermissions{CanSendMessages: true}
+	if member.Permissions() != expected {
+		t.Fatalf("Unexpected permissions: %+v", member.Permissions())
+	}
+}
+
+func TestBotSetChatPhoto(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("photo")
+		if err != nil {
+			t.Errorf("No photo uploaded: %v", err)
+			return
+		}
+		content, _ := ioutil.ReadAll(file)
+		if !strings.HasSuffix(r.URL.Path, "/setChatPhoto") || header.Filename != "logo.png" || string(content) != "png" || r.FormValue("chat_id") != "-100" {
+			t.Errorf("Unexpected upload %v: %s, form %v", header.Filename, content, r.Form)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	ok, _, err := bot.SetChatPhoto(context.Background(), Integer(-100), FileBytes("logo.png", []byte("png")))
+	if err != nil || !ok {
+		t.Fatal("bot.SetChatPhoto() failed: ", ok, err)
+	}
+
+	if _, _, err = bot.SetChatPhoto(context.Background(), Integer(-100), FileID("AgAD")); err == nil {
+		t.Fatal("bot.SetChatPhoto() must reject file_id")
+	}
+}
+
+func TestBotPinChatMessage(t *testing.T) {
+	bot := newTestAdminBot(t, "pinChatMessage", map[string]string{"chat_id": "-100", "message_id": "5", "disable_notification": "true"})
+	if ok, _, err := bot.PinChatMessage(context.Background(), Integer(-100), 5, true); err != nil || !ok {
+		t.Fatal("bot.PinChatMessage() failed: ", ok, err)
+	}
+}
+
+func TestBotSetChatDescription(t *testing.T) {
+	bot := newTestAdminBot(t, "setChatDescription", map[string]string{"chat_id": "@group", "description": ""})
+	if ok, _, err := bot.SetChatDescription(context.Background(), "@group", ""); err != nil || !ok {
+		t.Fatal("bot.SetChatDescription() failed: ", ok, err)
+	}
+}
+
+func TestBotExportChatInviteLink(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 200, `{"ok":true,"result":"https://t.me/joinchat/AAAA"}`)
+	})
+
+	link, _, err := bot.ExportChatInviteLink(context.Background(), Integer(-100))
+	if err != nil || link != "https://t.me/joinchat/AAAA" {
+		t.Fatal("bot.ExportChatInviteLink() failed: ", link, err)
+	}
+}
+
+func TestBotGetChatPinnedMessage(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 200, `{"ok":true,"result":{"id":-100,"type":"supergroup","pinned_message":{"message_id":5,"date":0,"chat":{"id":-100,"type":"supergroup"}}}}`)
+	})
+
+	chat, _, err := bot.GetChat(context.Background(), Integer(-100))
+	if err != nil || chat.PinnedMessage == nil || chat.PinnedMessage.ID != 5 {
+		t.Fatal("Pinned message is not parsed: ", chat, err)
+	}
+}
@@ -0,0 +1,30 @@
+package tgbot
+
+import (
+	"context"
+	"time"
+)
+
+// clock time source of retries and rate limits, replaced with fake one in tests
+type clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error // sleep for d or until ctx is done
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,197 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf16"
+)
+
+// Command bot command parsed from message starting with bot_command entity, e.g. "/help@OurBot args"
+type Command struct {
+	Name    string   // Command name without leading / and @botname, lowercase
+	Mention string   // Bot username the command is addressed to, empty if not mentioned
+	RawArgs string   // Text after the command, trimmed
+	Args    []string // RawArgs split by whitespace, quoted arguments are kept together
+	Message *Message // Message with the command
+}
+
+// Payload deep-linking payload of /start command https://core.telegram.org/bots#deep-linking
+func (command *Command) Payload() string {
+	if command.Name != "start" {
+		return ""
+	}
+	return command.RawArgs
+}
+
+// ParseCommand parse command from message text starting with bot_command entity
+func ParseCommand(message *Message) (*Command, bool) {
+	if message == nil || message.Text == nil {
+		return nil, false
+	}
+
+	for _, entity := range message.Entities {
+		if entity.Type != "bot_command" || entity.Offset != 0 {
+			continue
+		}
+
+		text := utf16.Encode([]rune(*message.Text))
+		if int(entity.Length) > len(text) || entity.Length < 2 {
+			return nil, false
+		}
+
+		name := string(utf16.Decode(text[1:entity.Length]))
+		command := &Command{Message: message}
+		if at := strings.Index(name, "@"); at >= 0 {
+			name, command.Mention = name[:at], name[at+1:]
+		}
+		command.Name = strings.ToLower(name)
+		command.RawArgs = strings.TrimSpace(string(utf16.Decode(text[entity.Length:])))
+		command.Args = SplitArgs(command.RawArgs)
+		return command, true
+	}
+	return nil, false
+}
+
+// SplitArgs split command arguments by whitespace, arguments in "double", 'single' or “smart” quotes are kept together.
+// Quotes open only at the start of argument, so apostrophes inside words (don't) are kept as is.
+func SplitArgs(rawArgs string) []string {
+	var args []string
+	var arg strings.Builder
+	inArg, quote := false, rune(0)
+	for _, r := range rawArgs {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			arg.WriteRune(r)
+		case !inArg && (r == '"' || r == '\''):
+			quote, inArg = r, true
+		case !inArg && r == '“':
+			quote, inArg = '”', true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args
+}
+
+// CommandHandlerFunc handler of bot command
+type CommandHandlerFunc func(ctx context.Context, command *Command) error
+
+type commandEntry struct {
+	description string
+	handler     CommandHandlerFunc
+}
+
+// CommandRouter routes messages with bot commands to handlers registered by command name.
+// Commands addressed to other bots (/help@OtherBot) are ignored. Use HandleMessage as Dispatcher.OnMessage handler.
+type CommandRouter struct {
+	Username string // Bot username without @, commands mentioning other usernames are ignored
+
+	// Optional
+	Bot *Bot // Optional. Used to answer /help with HelpText, if no help handler is registered
+
+	mutex    sync.RWMutex
+	commands map[string]commandEntry
+}
+
+// NewCommandRouter create CommandRouter for bot with username
+func NewCommandRouter(username string) *CommandRouter {
+	return &CommandRouter{Username: username, commands: map[string]commandEntry{}}
+}
+
+// NewBotCommandRouter create CommandRouter for bot with username from getMe, answering /help with HelpText
+func NewBotCommandRouter(ctx context.Context, bot *Bot) (*CommandRouter, error) {
+	user, _, err := bot.GetMe(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tgbot.NewBotCommandRouter: %w", err)
+	}
+	if user.Username == nil {
+		return nil, errors.New("tgbot.NewBotCommandRouter: bot has no username")
+	}
+
+	router := NewCommandRouter(*user.Username)
+	router.Bot = bot
+	return router, nil
+}
+
+// Handle register handler of command name (without leading /), description is shown in HelpText
+func (router *CommandRouter) Handle(name string, description string, handler CommandHandlerFunc) {
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+	if router.commands == nil {
+		router.commands = map[string]commandEntry{}
+	}
+	router.commands[strings.ToLower(strings.TrimPrefix(name, "/"))] = commandEntry{description, handler}
+}
+
+// HelpText list of registered commands with descriptions, one per line
+func (router *CommandRouter) HelpText() string {
+	router.mutex.RLock()
+	defer router.mutex.RUnlock()
+
+	names := make([]string, 0, len(router.commands))
+	for name := range router.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		line := "/" + name
+		if description := router.commands[name].description; len(description) > 0 {
+			line += " - " + description
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// HandleMessage MessageHandlerFunc dispatching commands to registered handlers.
+// Returns ErrStopPropagation after a command is handled, nil for other messages and unknown commands.
+func (router *CommandRouter) HandleMessage(ctx context.Context, message *Message) error {
+	command, ok := ParseCommand(message)
+	if !ok {
+		return nil
+	}
+
+	if len(command.Mention) > 0 && !strings.EqualFold(command.Mention, router.Username) {
+		return nil
+	}
+
+	router.mutex.RLock()
+	entry, ok := router.commands[command.Name]
+	router.mutex.RUnlock()
+
+	if !ok {
+		if command.Name != "help" || router.Bot == nil {
+			return nil
+		}
+		entry.handler = router.answerHelp
+	}
+
+	if err := entry.handler(ctx, command); err != nil {
+		return fmt.Errorf("tgbot.CommandRouter: /%v: %w", command.Name, err)
+	}
+	return ErrStopPropagation
+}
+
+func (router *CommandRouter) answerHelp(ctx context.Context, command *Command) error {
+	_, _, err := router.Bot.SendMessage(ctx, Params{"chat_id": command.Message.Chat.ID, "text": router.HelpText()})
+	return err
+}
@@ -0,0 +1,135 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestCommandMessage(text string, commandLength int) *Message {
+	return &Message{
+		Chat:     Chat{ID: -100, Type: "supergroup"},
+		Text:     &text,
+		Entities: []MessageEntity{{Type: "bot_command", Offset: 0, Length: Integer(commandLength)}},
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	command, ok := ParseCommand(newTestCommandMessage(`/Vote@OurBot 3 "Option with spaces" 'single' “smart quotes”`, 12))
+	if !ok {
+		t.Fatal("ParseCommand() failed")
+	}
+
+	if command.Name != "vote" || command.Mention != "OurBot" {
+		t.Fatalf("Unexpected command: %+v", command)
+	}
+
+	expectedArgs := []string{"3", "Option with spaces", "single", "smart quotes"}
+	if !reflect.DeepEqual(command.Args, expectedArgs) {
+		t.Fatalf("Unexpected args: %q", command.Args)
+	}
+
+	// entity offsets are in UTF-16 code units
+	command, ok = ParseCommand(newTestCommandMessage("/start 😀payload", 6))
+	if !ok || command.Payload() != "😀payload" {
+		t.Fatalf("Unexpected deep link payload: %+v", command)
+	}
+
+	text := "not a /command"
+	if _, ok = ParseCommand(&Message{Text: &text, Entities: []MessageEntity{{Type: "bot_command", Offset: 6, Length: 8}}}); ok {
+		t.Fatal("Command should be at the start of message")
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	for rawArgs, expected := range map[string][]string{
+		"remind me don't forget milk":  {"remind", "me", "don't", "forget", "milk"},
+		"it's 5 o'clock":               {"it's", "5", "o'clock"},
+		`say "hello world" 'bye all'`:  {"say", "hello world", "bye all"},
+		"  spaced   out  “smart one” ": {"spaced", "out", "smart one"},
+	} {
+		if args := SplitArgs(rawArgs); !reflect.DeepEqual(args, expected) {
+			t.Fatalf("SplitArgs(%q): unexpected args %q", rawArgs, args)
+		}
+	}
+}
+
+func TestCommandRouter(t *testing.T) {
+	var calls []string
+	router := NewCommandRouter("OurBot")
+	router.Handle("/start", "Start the bot", func(ctx context.Context, command *Command) error {
+		calls = append(calls, "start:"+command.Payload())
+		return nil
+	})
+	router.Handle("echo", "", func(ctx context.Context, command *Command) error {
+		calls = append(calls, fmt.Sprintf("echo:%q", command.Args))
+		return nil
+	})
+
+	messages := map[*Message]error{
+		newTestCommandMessage("/start abc", 6):              ErrStopPropagation,
+		newTestCommandMessage("/echo@ourbot a 'b c'", 12):   ErrStopPropagation,
+		newTestCommandMessage("/echo@OtherBot ignored", 14): nil,
+		newTestCommandMessage("/unknown", 8):                nil,
+		{Text: new(string)}:                                 nil,
+	}
+	for message, expected := range messages {
+		if err := router.HandleMessage(context.Background(), message); err != expected {
+			t.Fatalf("Unexpected result for %q: %v", *message.Text, err)
+		}
+	}
+
+	if strings.Join(calls, " ") != `start:abc echo:["a" "b c"]` && strings.Join(calls, " ") != `echo:["a" "b c"] start:abc` {
+		t.Fatalf("Unexpected calls: %v", calls)
+	}
+
+	if help := router.HelpText(); help != "/echo\n/start - Start the bot" {
+		t.Fatal("Unexpected help: " + help)
+	}
+}
+
+func TestCommandRouterLiteral(t *testing.T) {
+	router := &CommandRouter{Username: "OurBot"}
+	if err := router.HandleMessage(context.Background(), newTestCommandMessage("/start", 6)); err != nil {
+		t.Fatal("Unknown command must pass through, got ", err)
+	}
+
+	router.Handle("start", "", func(ctx context.Context, command *Command) error {
+		return nil
+	})
+	if err := router.HandleMessage(context.Background(), newTestCommandMessage("/start", 6)); err != ErrStopPropagation {
+		t.Fatal("Expected ErrStopPropagation, got ", err)
+	}
+}
+
+func TestBotCommandRouterHelp(t *testing.T) {
+	var helpText string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getMe") {
+			writeTestResponse(w, 200, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"Our","username":"OurBot"}}`)
+			return
+		}
+		helpText = r.URL.Query().Get("text")
+		writeTestResponse(w, 200, testMessageResponse)
+	})
+
+	router, err := NewBotCommandRouter(context.Background(), bot)
+	if err != nil {
+		t.Fatal("NewBotCommandRouter() failed: " + err.Error())
+	}
+	router.Handle("ping", "Check the bot is alive", func(ctx context.Context, command *Command) error { return nil })
+
+	d := NewDispatcher()
+	d.OnMessage(router.HandleMessage)
+	update := &Update{Message: newTestCommandMessage("/help@OurBot", 12)}
+	if err = d.HandleUpdate(context.Background(), update); err != nil {
+		t.Fatal("d.HandleUpdate() failed: " + err.Error())
+	}
+
+	if helpText != "/ping - Check the bot is alive" {
+		t.Fatal("Unexpected help text: " + helpText)
+	}
+}
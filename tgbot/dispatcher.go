@@ -0,0 +1,214 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrStopPropagation returned by a handler registered in Dispatcher prevents the following handlers from running
+var ErrStopPropagation = errors.New("tgbot: stop propagation")
+
+// UpdateKind kind of Update, json name of its optional field
+type UpdateKind string
+
+// Update kinds
+const (
+	KindMessage            UpdateKind = "message"
+	KindEditedMessage      UpdateKind = "edited_message"
+	KindChannelPost        UpdateKind = "channel_post"
+	KindEditedChannelPost  UpdateKind = "edited_channel_post"
+	KindInlineQuery        UpdateKind = "inline_query"
+	KindChosenInlineResult UpdateKind = "chosen_inline_result"
+	KindCallbackQuery      UpdateKind = "callback_query"
+	KindShippingQuery      UpdateKind = "shipping_query"
+	KindPreCheckoutQuery   UpdateKind = "pre_checkout_query"
+)
+
+// Kind kind of update, empty if update has none of known optional fields
+func (update *Update) Kind() UpdateKind {
+	switch {
+	case update.Message != nil:
+		return KindMessage
+	case update.EditedMessage != nil:
+		return KindEditedMessage
+	case update.ChannelPost != nil:
+		return KindChannelPost
+	case update.EditedChannelPost != nil:
+		return KindEditedChannelPost
+	case update.InlineQuery != nil:
+		return KindInlineQuery
+	case update.ChosenInlineResult != nil:
+		return KindChosenInlineResult
+	case update.CallbackQuery != nil:
+		return KindCallbackQuery
+	case update.ShippingQuery != nil:
+		return KindShippingQuery
+	case update.PreCheckoutQuery != nil:
+		return KindPreCheckoutQuery
+	}
+	return ""
+}
+
+// Chat chat of the message or the callback query message, nil if update has no chat
+func (update *Update) Chat() *Chat {
+	for _, message := range []*Message{update.Message, update.EditedMessage, update.ChannelPost, update.EditedChannelPost} {
+		if message != nil {
+			return &message.Chat
+		}
+	}
+	if update.CallbackQuery != nil && update.CallbackQuery.Message != nil {
+		return &update.CallbackQuery.Message.Chat
+	}
+	return nil
+}
+
+// Sender user who sent the message or the query, nil if unknown (e.g. channel posts)
+func (update *Update) Sender() *User {
+	for _, message := range []*Message{update.Message, update.EditedMessage, update.ChannelPost, update.EditedChannelPost} {
+		if message != nil {
+			return message.From
+		}
+	}
+	switch {
+	case update.InlineQuery != nil:
+		return &update.InlineQuery.From
+	case update.ChosenInlineResult != nil:
+		return &update.ChosenInlineResult.From
+	case update.CallbackQuery != nil:
+		return &update.CallbackQuery.From
+	case update.ShippingQuery != nil:
+		return &update.ShippingQuery.From
+	case update.PreCheckoutQuery != nil:
+		return &update.PreCheckoutQuery.From
+	}
+	return nil
+}
+
+// Typed handlers of update kinds
+type (
+	MessageHandlerFunc            func(ctx context.Context, message *Message) error
+	InlineQueryHandlerFunc        func(ctx context.Context, inlineQuery *InlineQuery) error
+	ChosenInlineResultHandlerFunc func(ctx context.Context, chosenInlineResult *ChosenInlineResult) error
+	CallbackQueryHandlerFunc      func(ctx context.Context, callbackQuery *CallbackQuery) error
+	ShippingQueryHandlerFunc      func(ctx context.Context, shippingQuery *ShippingQuery) error
+	PreCheckoutQueryHandlerFunc   func(ctx context.Context, preCheckoutQuery *PreCheckoutQuery) error
+)
+
+// Dispatcher Handler routing updates to handlers registered for their kind.
+// Handlers of a kind run in registration order until one returns an error,
+// ErrStopPropagation stops them without error. Fallback handles updates of kinds without handlers.
+type Dispatcher struct {
+	mutex       sync.RWMutex
+	handlers    map[UpdateKind][]Handler
+	fallback    Handler
+	middlewares []Middleware
+}
+
+// NewDispatcher create Dispatcher without handlers
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{handlers: map[UpdateKind][]Handler{}}
+}
+
+// Handle register handler for updates of kind
+func (d *Dispatcher) Handle(kind UpdateKind, handler Handler) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.handlers[kind] = append(d.handlers[kind], handler)
+}
+
+// Use add middlewares wrapping handling of every update, the first one is the outermost
+func (d *Dispatcher) Use(middlewares ...Middleware) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.middlewares = append(d.middlewares, middlewares...)
+}
+
+// Fallback set handler of updates without handlers registered for their kind
+func (d *Dispatcher) Fallback(handler Handler) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.fallback = handler
+}
+
+// OnMessage register handler of Update.Message
+func (d *Dispatcher) OnMessage(f MessageHandlerFunc) {
+	d.Handle(KindMessage, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.Message) }))
+}
+
+// OnEditedMessage register handler of Update.EditedMessage
+func (d *Dispatcher) OnEditedMessage(f MessageHandlerFunc) {
+	d.Handle(KindEditedMessage, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.EditedMessage) }))
+}
+
+// OnChannelPost register handler of Update.ChannelPost
+func (d *Dispatcher) OnChannelPost(f MessageHandlerFunc) {
+	d.Handle(KindChannelPost, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.ChannelPost) }))
+}
+
+// OnEditedChannelPost register handler of Update.EditedChannelPost
+func (d *Dispatcher) OnEditedChannelPost(f MessageHandlerFunc) {
+	d.Handle(KindEditedChannelPost, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.EditedChannelPost) }))
+}
+
+// OnInlineQuery register handler of Update.InlineQuery
+func (d *Dispatcher) OnInlineQuery(f InlineQueryHandlerFunc) {
+	d.Handle(KindInlineQuery, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.InlineQuery) }))
+}
+
+// OnChosenInlineResult register handler of Update.ChosenInlineResult
+func (d *Dispatcher) OnChosenInlineResult(f ChosenInlineResultHandlerFunc) {
+	d.Handle(KindChosenInlineResult, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.ChosenInlineResult) }))
+}
+
+// OnCallbackQuery register handler of Update.CallbackQuery
+func (d *Dispatcher) OnCallbackQuery(f CallbackQueryHandlerFunc) {
+	d.Handle(KindCallbackQuery, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.CallbackQuery) }))
+}
+
+// OnShippingQuery register handler of Update.ShippingQuery
+func (d *Dispatcher) OnShippingQuery(f ShippingQueryHandlerFunc) {
+	d.Handle(KindShippingQuery, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.ShippingQuery) }))
+}
+
+// OnPreCheckoutQuery register handler of Update.PreCheckoutQuery
+func (d *Dispatcher) OnPreCheckoutQuery(f PreCheckoutQueryHandlerFunc) {
+	d.Handle(KindPreCheckoutQuery, HandlerFunc(func(ctx context.Context, update *Update) error { return f(ctx, update.PreCheckoutQuery) }))
+}
+
+// HandleUpdate implements Handler. Update is available to handlers with UpdateFromContext.
+func (d *Dispatcher) HandleUpdate(ctx context.Context, update *Update) error {
+	d.mutex.RLock()
+	middlewares := d.middlewares
+	d.mutex.RUnlock()
+
+	ctx = context.WithValue(ctx, updateContextKey{}, update)
+	return Chain(HandlerFunc(d.dispatch), middlewares...).HandleUpdate(ctx, update)
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context, update *Update) error {
+	d.mutex.RLock()
+	handlers, fallback := d.handlers[update.Kind()], d.fallback
+	d.mutex.RUnlock()
+
+	if len(handlers) == 0 {
+		if fallback == nil {
+			return nil
+		}
+		return ignoreStopPropagation(fallback.HandleUpdate(ctx, update))
+	}
+
+	for _, handler := range handlers {
+		if err := handler.HandleUpdate(ctx, update); err != nil {
+			return ignoreStopPropagation(err)
+		}
+	}
+	return nil
+}
+
+func ignoreStopPropagation(err error) error {
+	if errors.Is(err, ErrStopPropagation) {
+		return nil
+	}
+	return err
+}
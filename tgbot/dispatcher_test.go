@@ -0,0 +1,97 @@
+package tgbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func parseTestUpdate(t *testing.T, data string) *Update {
+	update := &Update{}
+	if err := json.Unmarshal([]byte(data), update); err != nil {
+		t.Fatal("Can't unmarshal update: " + err.Error())
+	}
+	return update
+}
+
+func TestUpdateKind(t *testing.T) {
+	kinds := map[string]UpdateKind{
+		`{"update_id":1,"message":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`:                            KindMessage,
+		`{"update_id":1,"edited_channel_post":{"message_id":1,"date":0,"chat":{"id":-1,"type":"channel"}}}`:               KindEditedChannelPost,
+		`{"update_id":1,"callback_query":{"id":"1","from":{"id":1,"is_bot":false,"first_name":"A"},"chat_instance":"1"}}`: KindCallbackQuery,
+		`{"update_id":1}`: "",
+	}
+	for data, expected := range kinds {
+		if kind := parseTestUpdate(t, data).Kind(); kind != expected {
+			t.Fatalf("Unexpected kind %v of %v", kind, data)
+		}
+	}
+}
+
+func TestDispatcherOrderAndStopPropagation(t *testing.T) {
+	var calls []string
+	d := NewDispatcher()
+	d.OnMessage(func(ctx context.Context, message *Message) error {
+		calls = append(calls, "first:"+*message.Text)
+		return nil
+	})
+	d.OnMessage(func(ctx context.Context, message *Message) error {
+		calls = append(calls, "second")
+		if *message.Text == "stop" {
+			return ErrStopPropagation
+		}
+		return nil
+	})
+	d.OnMessage(func(ctx context.Context, message *Message) error {
+		calls = append(calls, "third")
+		return nil
+	})
+	d.OnCallbackQuery(func(ctx context.Context, callbackQuery *CallbackQuery) error {
+		calls = append(calls, "callback:"+callbackQuery.ID)
+		return nil
+	})
+	d.Fallback(HandlerFunc(func(ctx context.Context, update *Update) error {
+		calls = append(calls, "fallback")
+		return nil
+	}))
+
+	updates := []string{
+		`{"update_id":1,"message":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"},"text":"go"}}`,
+		`{"update_id":2,"message":{"message_id":2,"date":0,"chat":{"id":1,"type":"private"},"text":"stop"}}`,
+		`{"update_id":3,"callback_query":{"id":"q","from":{"id":1,"is_bot":false,"first_name":"A"},"chat_instance":"1"}}`,
+		`{"update_id":4,"edited_message":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"},"text":"go"}}`,
+	}
+	for _, data := range updates {
+		if err := d.HandleUpdate(context.Background(), parseTestUpdate(t, data)); err != nil {
+			t.Fatal("d.HandleUpdate() failed: " + err.Error())
+		}
+	}
+
+	expected := "first:go second third first:stop second callback:q fallback"
+	if strings.Join(calls, " ") != expected {
+		t.Fatal("Unexpected calls: " + strings.Join(calls, " "))
+	}
+}
+
+func TestDispatcherError(t *testing.T) {
+	handlerErr := errors.New("handler failed")
+	called := false
+	d := NewDispatcher()
+	d.OnMessage(func(ctx context.Context, message *Message) error { return handlerErr })
+	d.OnMessage(func(ctx context.Context, message *Message) error {
+		called = true
+		return nil
+	})
+
+	update := parseTestUpdate(t, `{"update_id":1,"message":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`)
+	if err := d.HandleUpdate(context.Background(), update); err != handlerErr || called {
+		t.Fatalf("Handler error should stop propagation and be returned, got %v", err)
+	}
+
+	// no handlers and no fallback
+	if err := NewDispatcher().HandleUpdate(context.Background(), update); err != nil {
+		t.Fatal("Empty dispatcher failed: " + err.Error())
+	}
+}
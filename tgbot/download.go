@@ -0,0 +1,82 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// DownloadFile resolve file_path with getFile and stream file content to w.
+// Fails if the number of written bytes differs from File.FileSize, when it is known.
+func (bot *Bot) DownloadFile(ctx context.Context, fileID string, w io.Writer) (*File, int, error) {
+	file, status, err := bot.GetFile(ctx, fileID)
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.DownloadFile: %w", err)
+	}
+
+	if file.FilePath == nil || len(*file.FilePath) == 0 {
+		return file, status, errors.New("tgbot.DownloadFile: no file_path for " + fileID)
+	}
+
+	if len(bot.FileURL) == 0 {
+		return file, status, errors.New("tgbot.DownloadFile: Bot.FileURL is not set")
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, "GET", bot.FileURL+*file.FilePath, nil)
+	if err != nil {
+		return file, 0, fmt.Errorf("tgbot.DownloadFile: %w", err)
+	}
+
+	httpResponse, err := bot.httpClient().Do(httpRequest)
+	if err != nil {
+		return file, 0, fmt.Errorf("tgbot.DownloadFile: %w", err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return file, httpResponse.StatusCode, fmt.Errorf("tgbot.DownloadFile: %w", &APIError{
+			StatusCode:  httpResponse.StatusCode,
+			ErrorCode:   Integer(httpResponse.StatusCode),
+			Description: httpResponse.Status,
+		})
+	}
+
+	written, err := io.Copy(w, httpResponse.Body)
+	if err != nil {
+		return file, httpResponse.StatusCode, fmt.Errorf("tgbot.DownloadFile: %w", err)
+	}
+
+	if file.FileSize != nil && written != int64(*file.FileSize) {
+		return file, httpResponse.StatusCode, fmt.Errorf("tgbot.DownloadFile: got %v bytes, expected %v", written, *file.FileSize)
+	}
+
+	return file, httpResponse.StatusCode, nil
+}
+
+// DownloadToPath download file to path. The file is written to a temporary file next to path and renamed on success.
+func (bot *Bot) DownloadToPath(ctx context.Context, fileID string, path string) (*File, int, error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, 0, fmt.Errorf("tgbot.DownloadToPath: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	file, status, err := bot.DownloadFile(ctx, fileID, tmp)
+	closeErr := tmp.Close()
+	if err != nil {
+		return file, status, fmt.Errorf("tgbot.DownloadToPath: %w", err)
+	}
+	if closeErr != nil {
+		return file, status, fmt.Errorf("tgbot.DownloadToPath: %w", closeErr)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return file, status, fmt.Errorf("tgbot.DownloadToPath: %w", err)
+	}
+	return file, status, nil
+}
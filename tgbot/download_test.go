@@ -0,0 +1,87 @@
+package tgbot
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func newTestDownloadBot(t *testing.T, fileSize int, content string) *Bot {
+	return newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bot" + testToken + "/getFile":
+			if r.URL.Query().Get("file_id") != "BQAD" {
+				t.Errorf("Unexpected file_id: %v", r.URL.Query().Get("file_id"))
+			}
+			writeTestResponse(w, 200, `{"ok":true,"result":{"file_id":"BQAD","file_size":`+
+				strconv.Itoa(fileSize)+`,"file_path":"documents/file_1.txt"}}`)
+		case "/file/bot" + testToken + "/documents/file_1.txt":
+			w.Write([]byte(content))
+		default:
+			writeTestResponse(w, 404, `{"ok":false,"error_code":404,"description":"Not Found"}`)
+		}
+	})
+}
+
+func TestNewBotFileURL(t *testing.T) {
+	if bot := NewBot(testToken); bot.FileURL != "https://api.telegram.org/file/bot"+testToken+"/" {
+		t.Fatal("Unexpected bot.FileURL: " + bot.FileURL)
+	}
+
+	if bot := NewBotWithAPIURL("http://localhost:8081/bot" + testToken + "/"); bot.FileURL != "http://localhost:8081/file/bot"+testToken+"/" {
+		t.Fatal("Unexpected bot.FileURL: " + bot.FileURL)
+	}
+}
+
+func TestBotDownloadFile(t *testing.T) {
+	bot := newTestDownloadBot(t, 5, "hello")
+
+	var buffer bytes.Buffer
+	file, status, err := bot.DownloadFile(context.Background(), "BQAD", &buffer)
+	if err != nil {
+		t.Fatal("bot.DownloadFile() failed: " + err.Error())
+	}
+
+	if status != 200 || *file.FilePath != "documents/file_1.txt" || buffer.String() != "hello" {
+		t.Fatalf("Unexpected download: %v %+v %v", status, file, buffer.String())
+	}
+}
+
+func TestBotDownloadFileSizeMismatch(t *testing.T) {
+	bot := newTestDownloadBot(t, 9, "hello")
+
+	if _, _, err := bot.DownloadFile(context.Background(), "BQAD", ioutil.Discard); err == nil {
+		t.Fatal("bot.DownloadFile() should've failed on truncated file")
+	}
+}
+
+func TestBotDownloadToPath(t *testing.T) {
+	bot := newTestDownloadBot(t, 5, "hello")
+	path := filepath.Join(t.TempDir(), "file.txt")
+
+	if _, _, err := bot.DownloadToPath(context.Background(), "BQAD", path); err != nil {
+		t.Fatal("bot.DownloadToPath() failed: " + err.Error())
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil || string(content) != "hello" {
+		t.Fatalf("Unexpected file content: %s %v", content, err)
+	}
+
+	// failed download leaves no files
+	bot.FileURL = bot.FileURL + "missing/"
+	failedPath := filepath.Join(filepath.Dir(path), "failed.txt")
+	if _, _, err = bot.DownloadToPath(context.Background(), "BQAD", failedPath); !IsNotFound(err) {
+		t.Fatalf("bot.DownloadToPath() should've failed with not found, got %v", err)
+	}
+
+	entries, _ := ioutil.ReadDir(filepath.Dir(path))
+	if _, err = os.Stat(failedPath); err == nil || len(entries) != 1 {
+		t.Fatalf("Failed download left %v files", len(entries))
+	}
+}
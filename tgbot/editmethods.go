@@ -0,0 +1,54 @@
+package tgbot
+
+import (
+	"context"
+)
+
+// EditMessageTextParams https://core.telegram.org/bots/api#editmessagetext
+type EditMessageTextParams struct {
+	MessageRef
+	Text string `json:"text"` // New text of the message
+
+	// Optional
+	ParseMode             string                `json:"parse_mode,omitempty"`               // Optional. Send Markdown or HTML, if you want Telegram apps to show bold, italic, fixed-width text or inline URLs in your bot's message.
+	DisableWebPagePreview bool                  `json:"disable_web_page_preview,omitempty"` // Optional. Disables link previews for links in this message
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`             // Optional. Inline keyboard of the message
+}
+
+// EditMessageCaptionParams https://core.telegram.org/bots/api#editmessagecaption
+type EditMessageCaptionParams struct {
+	MessageRef
+
+	// Optional
+	Caption     string                `json:"caption,omitempty"`      // Optional. New caption of the message, 0-200 characters
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Optional. Inline keyboard of the message
+}
+
+// EditMessageText https://core.telegram.org/bots/api#editmessagetext
+// Edited Message is returned for chat messages, nil Message for inline messages.
+// Editing with the same content fails with error matching ErrMessageNotModified.
+func (bot *Bot) EditMessageText(ctx context.Context, params EditMessageTextParams) (*Message, int, error) {
+	return bot.callMessageRef(ctx, "editMessageText", params.MessageRef, paramsOf(params))
+}
+
+// EditMessageCaption https://core.telegram.org/bots/api#editmessagecaption
+// Edited Message is returned for chat messages, nil Message for inline messages.
+func (bot *Bot) EditMessageCaption(ctx context.Context, params EditMessageCaptionParams) (*Message, int, error) {
+	return bot.callMessageRef(ctx, "editMessageCaption", params.MessageRef, paramsOf(params))
+}
+
+// EditMessageReplyMarkup https://core.telegram.org/bots/api#editmessagereplymarkup
+// Inline keyboard is removed if replyMarkup is nil. Edited Message is returned for chat messages, nil Message for inline messages.
+func (bot *Bot) EditMessageReplyMarkup(ctx context.Context, ref MessageRef, replyMarkup *InlineKeyboardMarkup) (*Message, int, error) {
+	params := paramsOf(ref)
+	if replyMarkup != nil {
+		params["reply_markup"] = replyMarkup
+	}
+	return bot.callMessageRef(ctx, "editMessageReplyMarkup", ref, params)
+}
+
+// DeleteMessage https://core.telegram.org/bots/api#deletemessage
+// Messages sent via inline mode can't be deleted, Bot API accepts only chat_id with message_id.
+func (bot *Bot) DeleteMessage(ctx context.Context, chatID interface{}, messageID Integer) (bool, int, error) {
+	return bot.callBool(ctx, "deleteMessage", Params{"chat_id": chatID, "message_id": messageID})
+}
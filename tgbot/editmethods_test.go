@@ -0,0 +1,83 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBotEditMessageText(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("inline_message_id") == "im1" {
+			writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+			return
+		}
+		expected := map[string]string{
+			"chat_id":      "42",
+			"message_id":   "5",
+			"text":         "Page 2",
+			"reply_markup": `{"inline_keyboard":[[{"text":"Next","callback_data":"page:3"}]]}`,
+		}
+		for key, value := range expected {
+			if r.PostForm.Get(key) != value {
+				t.Errorf("Unexpected %v: %v", key, r.PostForm.Get(key))
+			}
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"message_id":5,"date":0,"chat":{"id":42,"type":"private"},"text":"Page 2"}}`)
+	})
+
+	data := "page:3"
+	message, _, err := bot.EditMessageText(context.Background(), EditMessageTextParams{
+		MessageRef:  ChatMessage(Integer(42), 5),
+		Text:        "Page 2",
+		ReplyMarkup: &InlineKeyboardMarkup{[][]InlineKeyboardButton{{{Text: "Next", CallbackData: &data}}}},
+	})
+	if err != nil {
+		t.Fatal("bot.EditMessageText() failed: " + err.Error())
+	}
+	if message.ID != 5 || *message.Text != "Page 2" {
+		t.Fatalf("Unexpected message: %+v", message)
+	}
+
+	message, _, err = bot.EditMessageText(context.Background(), EditMessageTextParams{MessageRef: InlineMessage("im1"), Text: "Page 2"})
+	if err != nil || message != nil {
+		t.Fatal("bot.EditMessageText() of inline message failed: ", message, err)
+	}
+
+	ref := ChatMessage(Integer(42), 5)
+	ref.InlineMessageID = "im1"
+	if _, _, err = bot.EditMessageText(context.Background(), EditMessageTextParams{MessageRef: ref, Text: "Page 2"}); err == nil {
+		t.Fatal("bot.EditMessageText() with both chat and inline message must fail")
+	}
+}
+
+func TestBotEditMessageNotModified(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 400, `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`)
+	})
+
+	_, _, err := bot.EditMessageReplyMarkup(context.Background(), ChatMessage(Integer(42), 5), nil)
+	if !errors.Is(err, ErrMessageNotModified) || !IsMessageNotModified(err) {
+		t.Fatal("Expected ErrMessageNotModified, got ", err)
+	}
+	if IsMessageNotModified(&APIError{ErrorCode: 400, Description: "Bad Request: message to edit not found"}) {
+		t.Fatal("Unexpected ErrMessageNotModified match")
+	}
+}
+
+func TestBotDeleteMessage(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if !strings.HasSuffix(r.URL.Path, "/deleteMessage") || r.PostForm.Get("chat_id") != "@channel" || r.PostForm.Get("message_id") != "5" {
+			t.Errorf("Unexpected request %v: %v", r.URL.Path, r.PostForm)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	if ok, _, err := bot.DeleteMessage(context.Background(), "@channel", 5); err != nil || !ok {
+		t.Fatal("bot.DeleteMessage() failed: ", ok, err)
+	}
+}
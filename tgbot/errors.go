@@ -0,0 +1,96 @@
+package tgbot
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrMessageNotModified matches APIError of edit methods called with the same content the message already has,
+// usually safe to ignore: errors.Is(err, ErrMessageNotModified)
+var ErrMessageNotModified = errors.New("tgbot: message is not modified")
+
+// APIError error reported by Telegram Bot API in response with Ok == false.
+// Use errors.As or AsAPIError to get it from errors returned by the package.
+type APIError struct {
+	StatusCode  int     // HTTP status code of the response
+	ErrorCode   Integer // error_code of the response, usually the same as StatusCode
+	Description string  // Human-readable description of the error
+
+	// Optional
+	Parameters *ResponseParameters // Optional. Tells how to handle the error (retry after, migrate to chat)
+}
+
+func (apiErr *APIError) Error() string {
+	return fmt.Sprintf("tgbot: Bot API error %v: %v", apiErr.ErrorCode, apiErr.Description)
+}
+
+// Is reports whether apiErr matches target, e.g. ErrMessageNotModified
+func (apiErr *APIError) Is(target error) bool {
+	return target == ErrMessageNotModified && apiErr.ErrorCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Description), "message is not modified")
+}
+
+// RetryAfter time to wait before the request can be repeated, 0 if not a flood control error
+func (apiErr *APIError) RetryAfter() time.Duration {
+	if apiErr.Parameters == nil || apiErr.Parameters.RetryAfter == nil {
+		return 0
+	}
+	return time.Duration(*apiErr.Parameters.RetryAfter) * time.Second
+}
+
+// MigrateToChatID supergroup identifier the group has been migrated to, false if not migrated
+func (apiErr *APIError) MigrateToChatID() (Integer, bool) {
+	if apiErr.Parameters == nil || apiErr.Parameters.MigrateToChatID == nil {
+		return 0, false
+	}
+	return *apiErr.Parameters.MigrateToChatID, true
+}
+
+// AsAPIError finds *APIError in err chain
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsFloodWait reports whether err is flood control error (429 Too Many Requests), see APIError.RetryAfter
+func IsFloodWait(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && (apiErr.ErrorCode == http.StatusTooManyRequests || apiErr.RetryAfter() > 0)
+}
+
+// IsChatMigrated reports whether err is caused by group migration to supergroup, see APIError.MigrateToChatID
+func IsChatMigrated(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	_, migrated := apiErr.MigrateToChatID()
+	return migrated
+}
+
+// IsForbidden reports whether err is 403 Forbidden, e.g. bot was blocked by the user or kicked from the chat
+func IsForbidden(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.ErrorCode == http.StatusForbidden
+}
+
+// IsNotFound reports whether err is 404 Not Found (e.g. invalid token) or 400 "... not found" (chat, message, user, etc.)
+func IsNotFound(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiErr.ErrorCode == http.StatusNotFound ||
+		(apiErr.ErrorCode == http.StatusBadRequest && strings.Contains(strings.ToLower(apiErr.Description), "not found"))
+}
+
+// IsMessageNotModified reports whether err is 400 "message is not modified" of edit methods, see ErrMessageNotModified
+func IsMessageNotModified(err error) bool {
+	return errors.Is(err, ErrMessageNotModified)
+}
@@ -0,0 +1,64 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 429, `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5","parameters":{"retry_after":5}}`)
+	})
+
+	_, status, err := bot.SendMessage(context.Background(), Params{"chat_id": 1, "text": "hi"})
+	if err == nil {
+		t.Fatal("bot.SendMessage() should've failed")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("errors.As(err, *APIError) failed for %v", err)
+	}
+
+	if status != 429 || apiErr.StatusCode != 429 || apiErr.ErrorCode != 429 {
+		t.Fatalf("Unexpected status codes: %v %+v", status, apiErr)
+	}
+
+	if apiErr.Description != "Too Many Requests: retry after 5" {
+		t.Fatal("Unexpected description: " + apiErr.Description)
+	}
+
+	if apiErr.RetryAfter() != 5*time.Second || !IsFloodWait(err) {
+		t.Fatalf("Flood wait is not detected: %v", apiErr.RetryAfter())
+	}
+
+	if IsChatMigrated(err) || IsForbidden(err) || IsNotFound(err) {
+		t.Fatal("Flood wait error matches other predicates")
+	}
+}
+
+func TestAPIErrorPredicates(t *testing.T) {
+	migrateTo := Integer(-1001234)
+	migrated := &APIError{ErrorCode: 400, Description: "Bad Request: group chat was upgraded to a supergroup chat",
+		Parameters: &ResponseParameters{MigrateToChatID: &migrateTo}}
+	if chatID, ok := migrated.MigrateToChatID(); !IsChatMigrated(migrated) || !ok || chatID != migrateTo {
+		t.Fatal("Chat migration is not detected")
+	}
+
+	blocked := &APIError{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"}
+	if !IsForbidden(blocked) || IsNotFound(blocked) {
+		t.Fatal("Forbidden error is not detected")
+	}
+
+	chatNotFound := &APIError{ErrorCode: 400, Description: "Bad Request: chat not found"}
+	if !IsNotFound(chatNotFound) || !IsNotFound(&APIError{ErrorCode: 404, Description: "Not Found"}) {
+		t.Fatal("Not found error is not detected")
+	}
+
+	if IsFloodWait(errors.New("429")) || IsNotFound(nil) {
+		t.Fatal("Non-API errors should not match predicates")
+	}
+}
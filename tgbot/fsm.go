@@ -0,0 +1,261 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Conversation conversation state passed to FSM state handlers
+type Conversation struct {
+	Key   string            // Storage key of the conversation, see FSM.Key
+	State string            // Current state
+	Data  map[string]string // Values collected during conversation, saved with the state
+
+	updatedAt time.Time
+	next      string
+	ended     bool
+	fsm       *FSM
+}
+
+// Transition move conversation to state after handler returns, fails if transition is not allowed
+func (conversation *Conversation) Transition(state string) error {
+	allowed, ok := conversation.fsm.allowedTransitions(conversation.State)
+	if !ok || !allowed[state] {
+		return fmt.Errorf("tgbot.Conversation.Transition: %v -> %v is not allowed", conversation.State, state)
+	}
+	conversation.next = state
+	return nil
+}
+
+// End finish conversation after handler returns, its state is deleted
+func (conversation *Conversation) End() {
+	conversation.ended = true
+}
+
+// StateHandlerFunc handler of updates in conversations being in some state
+type StateHandlerFunc func(ctx context.Context, conversation *Conversation, update *Update) error
+
+type fsmState struct {
+	handler     StateHandlerFunc
+	transitions map[string]bool
+}
+
+// FSM conversation state machine: named states, their handlers and allowed transitions.
+// Conversations are started with Start and kept in Storage by Key. FSM is a Handler:
+// updates of conversations are passed to the handler of their state and propagation is stopped,
+// other updates are passed through (nil is returned). Updates of the same conversation are handled one by one.
+type FSM struct {
+	Storage Storage       // Storage of conversation states
+	Key     UpdateKeyFunc // Conversation key of update, ChatUserKey if nil
+
+	// Optional
+	Timeout   time.Duration    // Optional. Conversations idle for longer are expired on their next update or by Expire, never if 0
+	OnTimeout StateHandlerFunc // Optional. Called with expired conversation on its next update, not called by Expire
+
+	mutex     sync.RWMutex
+	states    map[string]fsmState
+	keysMutex sync.Mutex
+	keys      map[string]*fsmKeyLock // locks of conversations being handled
+	clock     clock                  // replaced in tests
+}
+
+type fsmKeyLock struct {
+	mutex sync.Mutex
+	refs  int
+}
+
+// ChatUserKey UpdateKeyFunc keying updates by chat and sender, so each user has own conversation in group chats
+func ChatUserKey(update *Update) string {
+	key := ""
+	if chat := update.Chat(); chat != nil {
+		key = strconv.FormatInt(int64(chat.ID), 10)
+	}
+	if sender := update.Sender(); sender != nil {
+		key += ":" + strconv.FormatInt(int64(sender.ID), 10)
+	}
+	return key
+}
+
+// NewFSM create FSM without states
+func NewFSM(storage Storage) *FSM {
+	return &FSM{Storage: storage, states: map[string]fsmState{}}
+}
+
+// State register state with handler and states it may transition to
+func (fsm *FSM) State(name string, handler StateHandlerFunc, transitions ...string) {
+	fsm.mutex.Lock()
+	defer fsm.mutex.Unlock()
+	if fsm.states == nil {
+		fsm.states = map[string]fsmState{}
+	}
+
+	allowed := map[string]bool{}
+	for _, transition := range transitions {
+		allowed[transition] = true
+	}
+	fsm.states[name] = fsmState{handler: handler, transitions: allowed}
+}
+
+// Start start conversation of update in state with data, overwriting the current one
+func (fsm *FSM) Start(ctx context.Context, update *Update, state string, data map[string]string) error {
+	if _, ok := fsm.allowedTransitions(state); !ok {
+		return errors.New("tgbot.FSM.Start: unknown state " + state)
+	}
+
+	key := fsm.key(update)
+	if len(key) == 0 {
+		return errors.New("tgbot.FSM.Start: update has no conversation key")
+	}
+
+	err := fsm.Storage.Set(ctx, key, &ConversationState{State: state, Data: data, UpdatedAt: fsm.now()})
+	if err != nil {
+		return fmt.Errorf("tgbot.FSM.Start: %w", err)
+	}
+	return nil
+}
+
+// Current state of update's conversation, false if there is no active conversation
+func (fsm *FSM) Current(ctx context.Context, update *Update) (*Conversation, bool, error) {
+	key := fsm.key(update)
+	if len(key) == 0 {
+		return nil, false, nil
+	}
+
+	state, ok, err := fsm.Storage.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	if state.Data == nil {
+		state.Data = map[string]string{}
+	}
+	return &Conversation{Key: key, State: state.State, Data: state.Data, updatedAt: state.UpdatedAt, fsm: fsm}, true, nil
+}
+
+// HandleUpdate implements Handler
+func (fsm *FSM) HandleUpdate(ctx context.Context, update *Update) error {
+	key := fsm.key(update)
+	if len(key) == 0 {
+		return nil
+	}
+	unlock := fsm.lockKey(key)
+	defer unlock()
+
+	conversation, ok, err := fsm.Current(ctx, update)
+	if err != nil {
+		return fmt.Errorf("tgbot.FSM: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if fsm.Timeout > 0 && fsm.now().Sub(conversation.updatedAt) > fsm.Timeout {
+		if err = fsm.Storage.Delete(ctx, conversation.Key); err != nil {
+			return fmt.Errorf("tgbot.FSM: %w", err)
+		}
+		if fsm.OnTimeout == nil {
+			return nil
+		}
+		if err = fsm.OnTimeout(ctx, conversation, update); err != nil {
+			return fmt.Errorf("tgbot.FSM: timeout of %v: %w", conversation.State, err)
+		}
+		return ErrStopPropagation
+	}
+
+	fsm.mutex.RLock()
+	entry, ok := fsm.states[conversation.State]
+	fsm.mutex.RUnlock()
+	if !ok {
+		return errors.New("tgbot.FSM: conversation " + conversation.Key + " is in unknown state " + conversation.State)
+	}
+
+	if err = entry.handler(ctx, conversation, update); err != nil {
+		return fmt.Errorf("tgbot.FSM: state %v: %w", conversation.State, err)
+	}
+
+	if conversation.ended {
+		err = fsm.Storage.Delete(ctx, conversation.Key)
+	} else {
+		next := conversation.State
+		if len(conversation.next) > 0 {
+			next = conversation.next
+		}
+		err = fsm.Storage.Set(ctx, conversation.Key, &ConversationState{State: next, Data: conversation.Data, UpdatedAt: fsm.now()})
+	}
+	if err != nil {
+		return fmt.Errorf("tgbot.FSM: %w", err)
+	}
+	return ErrStopPropagation
+}
+
+// Expire delete conversations idle for longer than Timeout, returns them sorted by key (e.g. to notify users).
+// Call it periodically, otherwise conversations of users who never write again are kept in Storage.
+func (fsm *FSM) Expire(ctx context.Context) ([]*Conversation, error) {
+	if fsm.Timeout <= 0 {
+		return nil, nil
+	}
+
+	states, err := fsm.Storage.Expire(ctx, fsm.now().Add(-fsm.Timeout))
+	if err != nil {
+		return nil, fmt.Errorf("tgbot.FSM.Expire: %w", err)
+	}
+
+	expired := make([]*Conversation, 0, len(states))
+	for key, state := range states {
+		expired = append(expired, &Conversation{Key: key, State: state.State, Data: state.Data, updatedAt: state.UpdatedAt, fsm: fsm})
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i].Key < expired[j].Key })
+	return expired, nil
+}
+
+// lockKey lock conversation of key, so its state is not changed by concurrent updates, returns unlock func
+func (fsm *FSM) lockKey(key string) func() {
+	fsm.keysMutex.Lock()
+	if fsm.keys == nil {
+		fsm.keys = map[string]*fsmKeyLock{}
+	}
+	lock, ok := fsm.keys[key]
+	if !ok {
+		lock = &fsmKeyLock{}
+		fsm.keys[key] = lock
+	}
+	lock.refs++
+	fsm.keysMutex.Unlock()
+
+	lock.mutex.Lock()
+	return func() {
+		lock.mutex.Unlock()
+
+		fsm.keysMutex.Lock()
+		defer fsm.keysMutex.Unlock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(fsm.keys, key)
+		}
+	}
+}
+
+func (fsm *FSM) allowedTransitions(state string) (map[string]bool, bool) {
+	fsm.mutex.RLock()
+	defer fsm.mutex.RUnlock()
+	entry, ok := fsm.states[state]
+	return entry.transitions, ok
+}
+
+func (fsm *FSM) key(update *Update) string {
+	if fsm.Key != nil {
+		return fsm.Key(update)
+	}
+	return ChatUserKey(update)
+}
+
+func (fsm *FSM) now() time.Time {
+	if fsm.clock == nil {
+		return time.Now()
+	}
+	return fsm.clock.Now()
+}
@@ -0,0 +1,195 @@
+package tgbot
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testFSMUpdate = `{"update_id":1,"message":{"message_id":1,"date":0,"chat":{"id":5,"type":"private"},"from":{"id":7,"is_bot":false,"first_name":"A"},"text":"Bob"}}`
+
+func newTestFSM() *FSM {
+	fsm := NewFSM(NewMemoryStorage())
+	fsm.State("name", func(ctx context.Context, conversation *Conversation, update *Update) error {
+		conversation.Data["name"] = *update.Message.Text
+		return conversation.Transition("age")
+	}, "age")
+	fsm.State("age", func(ctx context.Context, conversation *Conversation, update *Update) error {
+		conversation.End()
+		return nil
+	})
+	return fsm
+}
+
+func TestFSMTransitions(t *testing.T) {
+	ctx := context.Background()
+	fsm := newTestFSM()
+	update := parseTestUpdate(t, testFSMUpdate)
+
+	if err := fsm.HandleUpdate(ctx, update); err != nil {
+		t.Fatal("Update without conversation must pass through: ", err)
+	}
+
+	if err := fsm.Start(ctx, update, "name", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+	if err := fsm.HandleUpdate(ctx, update); err != ErrStopPropagation {
+		t.Fatal("Expected ErrStopPropagation, got ", err)
+	}
+
+	conversation, ok, err := fsm.Current(ctx, update)
+	if err != nil || !ok {
+		t.Fatal("Current failed: ", ok, err)
+	}
+	if conversation.Key != "5:7" || conversation.State != "age" || conversation.Data["name"] != "Bob" {
+		t.Fatalf("Unexpected conversation %+v", conversation)
+	}
+
+	if err = fsm.HandleUpdate(ctx, update); err != ErrStopPropagation {
+		t.Fatal("Expected ErrStopPropagation, got ", err)
+	}
+	if _, ok, _ = fsm.Current(ctx, update); ok {
+		t.Fatal("Conversation is not ended")
+	}
+}
+
+func TestFSMTransitionNotAllowed(t *testing.T) {
+	ctx := context.Background()
+	fsm := newTestFSM()
+	update := parseTestUpdate(t, testFSMUpdate)
+
+	fsm.State("age", func(ctx context.Context, conversation *Conversation, update *Update) error {
+		return conversation.Transition("name")
+	})
+	if err := fsm.Start(ctx, update, "age", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+	if err := fsm.HandleUpdate(ctx, update); err == nil || err == ErrStopPropagation {
+		t.Fatal("Expected transition error, got ", err)
+	}
+
+	conversation, _, _ := fsm.Current(ctx, update)
+	if conversation.State != "age" {
+		t.Fatal("State changed after failed handler: " + conversation.State)
+	}
+
+	if err := fsm.Start(ctx, update, "unknown", nil); err == nil {
+		t.Fatal("Start in unknown state must fail")
+	}
+}
+
+func TestFSMTimeout(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+	fsm := newTestFSM()
+	fsm.clock = clock
+	fsm.Timeout = time.Minute
+
+	timedOut := ""
+	fsm.OnTimeout = func(ctx context.Context, conversation *Conversation, update *Update) error {
+		timedOut = conversation.State
+		return nil
+	}
+
+	update := parseTestUpdate(t, testFSMUpdate)
+	if err := fsm.Start(ctx, update, "name", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+	clock.Sleep(ctx, 2*time.Minute)
+
+	if err := fsm.HandleUpdate(ctx, update); err != ErrStopPropagation {
+		t.Fatal("Expected ErrStopPropagation, got ", err)
+	}
+	if timedOut != "name" {
+		t.Fatal("OnTimeout is not called")
+	}
+	if _, ok, _ := fsm.Current(ctx, update); ok {
+		t.Fatal("Expired conversation is not deleted")
+	}
+}
+
+func TestFSMExpire(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+	fsm := newTestFSM()
+	fsm.clock = clock
+	fsm.Timeout = time.Minute
+
+	stale := parseTestUpdate(t, testFSMUpdate)
+	fresh := parseTestUpdate(t, testFSMUpdate)
+	fresh.Message.From.ID = 8
+	if err := fsm.Start(ctx, stale, "name", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+	clock.Sleep(ctx, 50*time.Second)
+	if err := fsm.Start(ctx, fresh, "name", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+	clock.Sleep(ctx, 20*time.Second)
+
+	expired, err := fsm.Expire(ctx)
+	if err != nil {
+		t.Fatal("Expire failed: " + err.Error())
+	}
+	if len(expired) != 1 || expired[0].Key != "5:7" || expired[0].State != "name" {
+		t.Fatalf("Unexpected expired conversations %+v", expired)
+	}
+	if _, ok, _ := fsm.Current(ctx, stale); ok {
+		t.Fatal("Expired conversation is not deleted")
+	}
+	if _, ok, _ := fsm.Current(ctx, fresh); !ok {
+		t.Fatal("Fresh conversation should not expire")
+	}
+}
+
+func TestFSMLiteral(t *testing.T) {
+	fsm := &FSM{Storage: NewMemoryStorage()}
+	fsm.State("name", func(ctx context.Context, conversation *Conversation, update *Update) error {
+		conversation.End()
+		return nil
+	})
+
+	update := parseTestUpdate(t, testFSMUpdate)
+	if err := fsm.Start(context.Background(), update, "name", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+	if err := fsm.HandleUpdate(context.Background(), update); err != ErrStopPropagation {
+		t.Fatal("Expected ErrStopPropagation, got ", err)
+	}
+}
+
+func TestFSMConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	fsm := NewFSM(NewMemoryStorage())
+	fsm.State("count", func(ctx context.Context, conversation *Conversation, update *Update) error {
+		n, _ := strconv.Atoi(conversation.Data["n"])
+		time.Sleep(time.Millisecond)
+		conversation.Data["n"] = strconv.Itoa(n + 1)
+		return nil
+	})
+
+	update := parseTestUpdate(t, testFSMUpdate)
+	if err := fsm.Start(ctx, update, "count", nil); err != nil {
+		t.Fatal("Start failed: " + err.Error())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fsm.HandleUpdate(ctx, update)
+		}()
+	}
+	wg.Wait()
+
+	conversation, _, _ := fsm.Current(ctx, update)
+	if conversation.Data["n"] != "20" {
+		t.Fatalf("Concurrent updates of conversation were lost: %v handled", conversation.Data["n"])
+	}
+	if len(fsm.keys) != 0 {
+		t.Fatalf("Conversation locks are not released: %v", fsm.keys)
+	}
+}
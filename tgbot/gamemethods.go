@@ -0,0 +1,68 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SendGameParams https://core.telegram.org/bots/api#sendgame
+type SendGameParams struct {
+	ChatID        Integer `json:"chat_id"`         // Unique identifier for the target chat
+	GameShortName string  `json:"game_short_name"` // Short name of the game, serves as the unique identifier for the game. Set up your games via Botfather.
+
+	// Optional
+	SendOptions // Optional. ReplyMarkup must be InlineKeyboardMarkup with CallbackGame button first, if set
+}
+
+// SetGameScoreParams https://core.telegram.org/bots/api#setgamescore
+type SetGameScoreParams struct {
+	UserID Integer `json:"user_id"` // User identifier
+	Score  Integer `json:"score"`   // New score, must be non-negative
+	MessageRef
+
+	// Optional
+	Force              bool `json:"force,omitempty"`                // Optional. Pass True, if the high score is allowed to decrease. This can be useful when fixing mistakes or banning cheaters
+	DisableEditMessage bool `json:"disable_edit_message,omitempty"` // Optional. Pass True, if the game message should not be automatically edited to include the current scoreboard
+}
+
+// SendGame https://core.telegram.org/bots/api#sendgame
+func (bot *Bot) SendGame(ctx context.Context, params SendGameParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendGame", paramsOf(params))
+}
+
+// SetGameScore https://core.telegram.org/bots/api#setgamescore
+// Edited game Message is returned for chat messages, nil Message for inline messages
+func (bot *Bot) SetGameScore(ctx context.Context, params SetGameScoreParams) (*Message, int, error) {
+	return bot.callMessageRef(ctx, "setGameScore", params.MessageRef, paramsOf(params))
+}
+
+// GetGameHighScores https://core.telegram.org/bots/api#getgamehighscores
+func (bot *Bot) GetGameHighScores(ctx context.Context, userID Integer, ref MessageRef) ([]GameHighScore, int, error) {
+	if err := ref.validate(); err != nil {
+		return nil, 0, fmt.Errorf("tgbot.GetGameHighScores: %w", err)
+	}
+
+	params := paramsOf(ref)
+	params["user_id"] = userID
+	response, status, err := bot.Get(ctx, "getGameHighScores", params)
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.GetGameHighScores: %w", err)
+	}
+
+	var highScores []GameHighScore
+	if err = response.GetResult(&highScores); err != nil {
+		return nil, status, fmt.Errorf("tgbot.GetGameHighScores: %w", err)
+	}
+
+	return highScores, status, nil
+}
+
+// AnswerGameCallback answer callback query of game button with gameURL, so the client opens the game.
+// Queries without game_short_name are rejected, use them with other callback handlers.
+func (bot *Bot) AnswerGameCallback(ctx context.Context, query *CallbackQuery, gameURL string) (bool, int, error) {
+	if query.GameShortName == nil {
+		return false, 0, errors.New("tgbot.AnswerGameCallback: callback query " + query.ID + " is not a game query")
+	}
+	return bot.AnswerCallbackQuery(ctx, AnswerCallbackQueryParams{CallbackQueryID: query.ID, URL: gameURL})
+}
@@ -0,0 +1,86 @@
+package tgbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBotSetGameScore(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("inline_message_id") != "" {
+			if r.PostForm.Get("chat_id") != "" || r.PostForm.Get("force") != "true" || r.PostForm.Get("disable_edit_message") != "true" {
+				t.Errorf("Unexpected form: %v", r.PostForm)
+			}
+			writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+			return
+		}
+		if r.PostForm.Get("chat_id") != "42" || r.PostForm.Get("message_id") != "5" || r.PostForm.Get("user_id") != "7" || r.PostForm.Get("score") != "100" {
+			t.Errorf("Unexpected form: %v", r.PostForm)
+		}
+		writeTestResponse(w, 200, testMessageResponse)
+	})
+
+	message, _, err := bot.SetGameScore(context.Background(), SetGameScoreParams{UserID: 7, Score: 100, MessageRef: ChatMessage(Integer(42), 5)})
+	if err != nil || message == nil {
+		t.Fatal("bot.SetGameScore() failed: ", message, err)
+	}
+
+	message, _, err = bot.SetGameScore(context.Background(), SetGameScoreParams{
+		UserID: 7, Score: 10, MessageRef: InlineMessage("im1"), Force: true, DisableEditMessage: true,
+	})
+	if err != nil || message != nil {
+		t.Fatal("bot.SetGameScore() of inline message failed: ", message, err)
+	}
+
+	if _, _, err = bot.SetGameScore(context.Background(), SetGameScoreParams{UserID: 7, Score: 10}); err == nil {
+		t.Fatal("bot.SetGameScore() without target message must fail")
+	}
+}
+
+func TestBotGetGameHighScores(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/getGameHighScores") || r.URL.Query().Get("inline_message_id") != "im1" || r.URL.Query().Get("user_id") != "7" {
+			t.Errorf("Unexpected request: %v", r.URL)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":[{"position":1,"user":{"id":7,"is_bot":false,"first_name":"A"},"score":100}]}`)
+	})
+
+	highScores, _, err := bot.GetGameHighScores(context.Background(), 7, InlineMessage("im1"))
+	if err != nil {
+		t.Fatal("bot.GetGameHighScores() failed: " + err.Error())
+	}
+	if len(highScores) != 1 || highScores[0].Score != 100 || highScores[0].User.ID != 7 {
+		t.Fatalf("Unexpected high scores: %+v", highScores)
+	}
+}
+
+func TestBotAnswerGameCallback(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if !strings.HasSuffix(r.URL.Path, "/answerCallbackQuery") || r.PostForm.Get("callback_query_id") != "cq1" || r.PostForm.Get("url") != "https://example.com/snake" {
+			t.Errorf("Unexpected request %v: %v", r.URL.Path, r.PostForm)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	update := parseTestUpdate(t, `{"update_id":1,"callback_query":{"id":"cq1","from":{"id":7,"is_bot":false,"first_name":"A"},"chat_instance":"1","game_short_name":"snake"}}`)
+	if ok, _, err := bot.AnswerGameCallback(context.Background(), update.CallbackQuery, "https://example.com/snake"); err != nil || !ok {
+		t.Fatal("bot.AnswerGameCallback() failed: ", ok, err)
+	}
+
+	update.CallbackQuery.GameShortName = nil
+	if _, _, err := bot.AnswerGameCallback(context.Background(), update.CallbackQuery, "https://example.com/snake"); err == nil {
+		t.Fatal("bot.AnswerGameCallback() must reject non-game query")
+	}
+}
+
+func TestCallbackGameButtonMarshal(t *testing.T) {
+	data, _ := json.Marshal(InlineKeyboardButton{Text: "Play", CallbackGame: &CallbackGame{}})
+	if string(data) != `{"text":"Play","callback_game":{}}` {
+		t.Fatalf("Unexpected button JSON: %s", data)
+	}
+}
@@ -0,0 +1,16 @@
+package tgbot
+
+import "context"
+
+// Handler handles single Update received by polling (Bot.PollUpdatesHandler) or webhook (WebhookHandler)
+type Handler interface {
+	HandleUpdate(ctx context.Context, update *Update) error
+}
+
+// HandlerFunc adapter to use ordinary function as Handler
+type HandlerFunc func(ctx context.Context, update *Update) error
+
+// HandleUpdate calls f(ctx, update)
+func (f HandlerFunc) HandleUpdate(ctx context.Context, update *Update) error {
+	return f(ctx, update)
+}
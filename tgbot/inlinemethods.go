@@ -0,0 +1,229 @@
+package tgbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// AnswerInlineQueryParams https://core.telegram.org/bots/api#answerinlinequery
+type AnswerInlineQueryParams struct {
+	InlineQueryID string              `json:"inline_query_id"` // Unique identifier for the answered query
+	Results       []InlineQueryResult `json:"results"`         // Results for the inline query, no more than 50 results per query
+
+	// Optional
+	CacheTime         *Integer `json:"cache_time,omitempty"`          // Optional. Maximum amount of time in seconds that the result of the inline query may be cached on the server. Defaults to 300.
+	IsPersonal        bool     `json:"is_personal,omitempty"`         // Optional. Pass True, if results may be cached on the server side only for the user that sent the query
+	NextOffset        string   `json:"next_offset,omitempty"`         // Optional. Offset that a client should send in the next query with the same text to receive more results. Pass an empty string if there are no more results. Offset length can't exceed 64 bytes.
+	SwitchPMText      string   `json:"switch_pm_text,omitempty"`      // Optional. If passed, clients will display a button with specified text that switches the user to a private chat with the bot and sends the bot a start message with the parameter switch_pm_parameter
+	SwitchPMParameter string   `json:"switch_pm_parameter,omitempty"` // Optional. Deep-linking parameter for the /start message sent to the bot when user presses the switch button. 1-64 characters, only A-Z, a-z, 0-9, _ and - are allowed.
+}
+
+// AnswerInlineQuery https://core.telegram.org/bots/api#answerinlinequery
+func (bot *Bot) AnswerInlineQuery(ctx context.Context, params AnswerInlineQueryParams) (bool, int, error) {
+	if len(params.Results) > 50 {
+		return false, 0, fmt.Errorf("tgbot.AnswerInlineQuery: %v results, no more than 50 allowed", len(params.Results))
+	}
+	if len(params.SwitchPMText) > 0 && len(params.SwitchPMParameter) == 0 {
+		return false, 0, errors.New("tgbot.AnswerInlineQuery: switch_pm_text requires switch_pm_parameter")
+	}
+	if params.Results == nil {
+		params.Results = []InlineQueryResult{}
+	}
+	return bot.callBool(ctx, "answerInlineQuery", paramsOf(params))
+}
+
+// marshalResult marshal InlineQueryResult fields v with "type" field first
+func marshalResult(resultType string, v interface{}) ([]byte, error) {
+	fields, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []byte(`{"type":` + fmt.Sprintf("%q", resultType))
+	if len(fields) > 2 {
+		data = append(data, ',')
+	}
+	return append(data, fields[1:]...), nil
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultArticle) ResultType() string { return "article" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultArticle
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultPhoto) ResultType() string { return "photo" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultPhoto
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultGif) ResultType() string { return "gif" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultGif
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultMpeg4Gif) ResultType() string { return "mpeg4_gif" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultMpeg4Gif
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultVideo) ResultType() string { return "video" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultVideo
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultAudio) ResultType() string { return "audio" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultAudio
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultVoice) ResultType() string { return "voice" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultVoice
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultDocument) ResultType() string { return "document" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultDocument
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultLocation) ResultType() string { return "location" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultLocation
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultVenue) ResultType() string { return "venue" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultVenue
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultContact) ResultType() string { return "contact" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultContact
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultGame) ResultType() string { return "game" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultGame
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedPhoto) ResultType() string { return "photo" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedPhoto
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedGif) ResultType() string { return "gif" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedGif
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedMpeg4Gif) ResultType() string { return "mpeg4_gif" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedMpeg4Gif
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedSticker) ResultType() string { return "sticker" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedSticker
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedDocument) ResultType() string { return "document" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedDocument
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedVideo) ResultType() string { return "video" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedVideo
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedVoice) ResultType() string { return "voice" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedVoice
+	return marshalResult(result.ResultType(), fields(result))
+}
+
+// ResultType implements InlineQueryResult
+func (result InlineQueryResultCachedAudio) ResultType() string { return "audio" }
+
+// MarshalJSON marshal result with "type" field
+func (result InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type fields InlineQueryResultCachedAudio
+	return marshalResult(result.ResultType(), fields(result))
+}
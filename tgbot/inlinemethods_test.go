@@ -0,0 +1,79 @@
+package tgbot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestInlineQueryResultMarshalType(t *testing.T) {
+	results := map[string]InlineQueryResult{
+		`{"type":"article","id":"1","title":"Hi","input_message_content":{"message_text":"Hello"}}`:                                                       InlineQueryResultArticle{ID: "1", Title: "Hi", InputMessageContent: InputTextMessageContent{MessageText: "Hello"}},
+		`{"type":"photo","id":"2","photo_file_id":"AgAD"}`:                                                                                                &InlineQueryResultCachedPhoto{ID: "2", PhotoFileID: "AgAD"},
+		`{"type":"sticker","id":"3","sticker_file_id":"CAAD"}`:                                                                                            InlineQueryResultCachedSticker{ID: "3", StickerFileID: "CAAD"},
+		`{"type":"mpeg4_gif","id":"4","mpeg4_url":"https://example.com/a.mp4","thumb_url":"https://example.com/a.jpg"}`:                                   InlineQueryResultMpeg4Gif{ID: "4", Mpeg4URL: "https://example.com/a.mp4", ThumbURL: "https://example.com/a.jpg"},
+		`{"type":"venue","id":"5","latitude":1.5,"longitude":2,"title":"Home","address":"Street","input_message_content":{"latitude":1.5,"longitude":2}}`: InlineQueryResultVenue{ID: "5", Latitude: 1.5, Longitude: 2, Title: "Home", Address: "Street", InputMessageContent: InputLocationMessageContent{Latitude: 1.5, Longitude: 2}},
+	}
+
+	for expected, result := range results {
+		data, err := json.Marshal(result)
+		if err != nil {
+			t.Fatal("json.Marshal() failed: " + err.Error())
+		}
+		if string(data) != expected {
+			t.Errorf("Unexpected %T JSON: %s", result, data)
+		}
+	}
+}
+
+func TestBotAnswerInlineQuery(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		expected := map[string]string{
+			"inline_query_id":     "q1",
+			"results":             `[{"type":"game","id":"g","game_short_name":"snake"}]`,
+			"cache_time":          "0",
+			"is_personal":         "true",
+			"next_offset":         "10",
+			"switch_pm_text":      "Sign in",
+			"switch_pm_parameter": "login",
+		}
+		for key, value := range expected {
+			if r.PostForm.Get(key) != value {
+				t.Errorf("Unexpected %v: %v", key, r.PostForm.Get(key))
+			}
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	cacheTime := Integer(0)
+	ok, _, err := bot.AnswerInlineQuery(context.Background(), AnswerInlineQueryParams{
+		InlineQueryID:     "q1",
+		Results:           []InlineQueryResult{InlineQueryResultGame{ID: "g", GameShortName: "snake"}},
+		CacheTime:         &cacheTime,
+		IsPersonal:        true,
+		NextOffset:        "10",
+		SwitchPMText:      "Sign in",
+		SwitchPMParameter: "login",
+	})
+	if err != nil || !ok {
+		t.Fatal("bot.AnswerInlineQuery() failed: ", ok, err)
+	}
+
+	if _, _, err = bot.AnswerInlineQuery(context.Background(), AnswerInlineQueryParams{InlineQueryID: "q1", SwitchPMText: "Sign in"}); err == nil {
+		t.Fatal("switch_pm_text without switch_pm_parameter must fail")
+	}
+}
+
+func TestInlineQueryUpdateSender(t *testing.T) {
+	update := parseTestUpdate(t, `{"update_id":1,"inline_query":{"id":"q1","from":{"id":7,"is_bot":false,"first_name":"A"},"query":"cats","offset":""}}`)
+	if update.InlineQuery.Query != "cats" || update.Sender() == nil || update.Sender().ID != 7 || ChatKey(update) != "7" {
+		t.Fatalf("Unexpected inline query update: %+v", update.InlineQuery)
+	}
+
+	update = parseTestUpdate(t, `{"update_id":2,"chosen_inline_result":{"result_id":"r1","from":{"id":8,"is_bot":false,"first_name":"B"},"inline_message_id":"im1","query":"cats"}}`)
+	if *update.ChosenInlineResult.InlineMessageID != "im1" || update.Sender() == nil || update.Sender().ID != 8 {
+		t.Fatalf("Unexpected chosen inline result update: %+v", update.ChosenInlineResult)
+	}
+}
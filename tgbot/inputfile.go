@@ -0,0 +1,93 @@
+package tgbot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// InputFile file to send https://core.telegram.org/bots/api#sending-files
+// Either uploaded with multipart/form-data (FilePath, FileReader, FileBytes)
+// or referenced by file_id or HTTP URL (FileID, FileURL).
+// Put *InputFile into Params, Bot.PostMultipartForm uploads it, other encodings send references only.
+type InputFile struct {
+	name string                        // upload filename
+	open func() (io.ReadCloser, error) // upload content, nil for references
+	ref  string                        // file_id or URL
+}
+
+// FilePath upload local file
+func FilePath(path string) *InputFile {
+	return &InputFile{
+		name: filepath.Base(path),
+		open: func() (io.ReadCloser, error) { return os.Open(path) },
+	}
+}
+
+// FileReader upload content of reader as file name. Reader can be read only once, so failed requests are not retried.
+func FileReader(name string, reader io.Reader) *InputFile {
+	var once sync.Once
+	return &InputFile{
+		name: name,
+		open: func() (io.ReadCloser, error) {
+			err := errors.New("tgbot.FileReader: " + name + " has already been read")
+			once.Do(func() { err = nil })
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(reader), nil
+		},
+	}
+}
+
+// FileBytes upload data as file name
+func FileBytes(name string, data []byte) *InputFile {
+	return &InputFile{
+		name: name,
+		open: func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(data)), nil },
+	}
+}
+
+// FileID file that is already stored on the Telegram servers
+func FileID(fileID string) *InputFile {
+	return &InputFile{ref: fileID}
+}
+
+// FileURL file that Telegram downloads from HTTP URL
+func FileURL(url string) *InputFile {
+	return &InputFile{ref: url}
+}
+
+// IsUpload true if file is uploaded with multipart/form-data, false for file_id and URL references
+func (file InputFile) IsUpload() bool {
+	return file.open != nil
+}
+
+// Name upload filename, empty for references
+func (file InputFile) Name() string {
+	return file.name
+}
+
+// MarshalJSON marshal file_id or URL reference, uploads can't be marshaled
+func (file InputFile) MarshalJSON() ([]byte, error) {
+	if file.IsUpload() {
+		return nil, errors.New("tgbot.InputFile.MarshalJSON: " + file.name + " should be uploaded with multipart/form-data")
+	}
+	return json.Marshal(file.ref)
+}
+
+// asInputFile get InputFile from Params value
+func asInputFile(value interface{}) (*InputFile, bool) {
+	switch file := value.(type) {
+	case *InputFile:
+		return file, file != nil
+	case InputFile:
+		return &file, true
+	}
+	return nil, false
+}
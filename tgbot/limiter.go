@@ -0,0 +1,198 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limiter throttles outgoing requests, see Bot.Limiter
+type Limiter interface {
+	// Wait blocks until request may be sent or ctx is done
+	Wait(ctx context.Context, methodName string, params Params) error
+}
+
+// Rate N events per period Per, unlimited if N or Per is 0
+type Rate struct {
+	N   int
+	Per time.Duration
+}
+
+func (rate Rate) unlimited() bool {
+	return rate.N <= 0 || rate.Per <= 0
+}
+
+// ChatLimiter token bucket Limiter matching Telegram limits https://core.telegram.org/bots/faq#my-bot-is-hitting-limits-how-do-i-avoid-this
+// Message sending requests (send* except sendChatAction, forwardMessage) with chat_id param pass a bucket of their chat and the global bucket,
+// other requests are not throttled: Telegram limits apply to messages only.
+// Chats with negative chat_id (groups, supergroups, channels) and @channelusername chats use GroupChat rate.
+type ChatLimiter struct {
+	Global      Rate // Limit for all chats together
+	PrivateChat Rate // Limit for each private chat
+	GroupChat   Rate // Limit for each group, supergroup or channel
+
+	mutex     sync.Mutex
+	global    tokenBucket
+	chats     map[string]*tokenBucket
+	lastPrune time.Time
+	clock     clock // replaced in tests
+}
+
+// NewChatLimiter ChatLimiter with Telegram defaults: 30 messages per second, 1 per second in private chat, 20 per minute in group
+func NewChatLimiter() *ChatLimiter {
+	return &ChatLimiter{
+		Global:      Rate{N: 30, Per: time.Second},
+		PrivateChat: Rate{N: 1, Per: time.Second},
+		GroupChat:   Rate{N: 20, Per: time.Minute},
+	}
+}
+
+// Wait implements Limiter
+func (limiter *ChatLimiter) Wait(ctx context.Context, methodName string, params Params) error {
+	chatID, ok := params["chat_id"]
+	if !ok || !isSendMethod(methodName) {
+		return nil
+	}
+	chatKey, isGroup := parseChatID(chatID)
+
+	rate := limiter.PrivateChat
+	if isGroup {
+		rate = limiter.GroupChat
+	}
+
+	clock := limiter.getClock()
+	chatBucket := limiter.chatBucket(chatKey, clock.Now())
+	if err := limiter.take(ctx, clock, chatBucket, rate); err != nil {
+		return fmt.Errorf("tgbot.ChatLimiter.Wait: %w", err)
+	}
+	if err := limiter.take(ctx, clock, &limiter.global, limiter.Global); err != nil {
+		// request is not sent, return its chat token too
+		if !rate.unlimited() {
+			limiter.mutex.Lock()
+			chatBucket.cancel(rate)
+			limiter.mutex.Unlock()
+		}
+		return fmt.Errorf("tgbot.ChatLimiter.Wait: %w", err)
+	}
+	return nil
+}
+
+// take wait for a token from bucket, the token is returned if ctx is done before
+func (limiter *ChatLimiter) take(ctx context.Context, clock clock, bucket *tokenBucket, rate Rate) error {
+	if rate.unlimited() {
+		return nil
+	}
+
+	limiter.mutex.Lock()
+	delay := bucket.reserve(rate, clock.Now())
+	limiter.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if err := clock.Sleep(ctx, delay); err != nil {
+		limiter.mutex.Lock()
+		bucket.cancel(rate)
+		limiter.mutex.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (limiter *ChatLimiter) getClock() clock {
+	if limiter.clock == nil {
+		return realClock{}
+	}
+	return limiter.clock
+}
+
+// chatBucket get or create bucket of chat, drops idle buckets once a minute
+func (limiter *ChatLimiter) chatBucket(chatKey string, now time.Time) *tokenBucket {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	if limiter.chats == nil {
+		limiter.chats = map[string]*tokenBucket{}
+	}
+
+	if now.Sub(limiter.lastPrune) > time.Minute {
+		maxPeriod := limiter.PrivateChat.Per
+		if limiter.GroupChat.Per > maxPeriod {
+			maxPeriod = limiter.GroupChat.Per
+		}
+		for key, bucket := range limiter.chats {
+			if now.Sub(bucket.last) > maxPeriod && bucket.tokens >= 0 {
+				delete(limiter.chats, key)
+			}
+		}
+		limiter.lastPrune = now
+	}
+
+	bucket, ok := limiter.chats[chatKey]
+	if !ok {
+		bucket = &tokenBucket{}
+		limiter.chats[chatKey] = bucket
+	}
+	return bucket
+}
+
+// isSendMethod reports whether Bot API method sends a message to chat
+func isSendMethod(methodName string) bool {
+	if methodName == "sendChatAction" {
+		return false
+	}
+	return strings.HasPrefix(methodName, "send") || methodName == "forwardMessage"
+}
+
+// parseChatID get bucket key of chat_id param and whether it's a group (or channel) chat
+func parseChatID(chatID interface{}) (string, bool) {
+	value := reflectData(chatID)
+	if !value.IsValid() {
+		return "", false
+	}
+	key := fmt.Sprint(value.Interface())
+	if strings.HasPrefix(key, "@") {
+		return key, true
+	}
+	id, err := strconv.ParseInt(key, 10, 64)
+	return key, err == nil && id < 0
+}
+
+// tokenBucket token bucket with up to Rate.N tokens refilled at Rate.N per Rate.Per, guarded by ChatLimiter.mutex.
+// Tokens may go negative: each reservation waits until its token is refilled.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	init   bool
+}
+
+// reserve take a token, returns time to wait until it's available
+func (bucket *tokenBucket) reserve(rate Rate, now time.Time) time.Duration {
+	perToken := rate.Per / time.Duration(rate.N)
+	if !bucket.init {
+		bucket.tokens, bucket.last, bucket.init = float64(rate.N), now, true
+	} else if now.After(bucket.last) {
+		bucket.tokens += float64(now.Sub(bucket.last)) / float64(perToken)
+		if bucket.tokens > float64(rate.N) {
+			bucket.tokens = float64(rate.N)
+		}
+		bucket.last = now
+	}
+
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens * float64(perToken))
+}
+
+// cancel return reserved token
+func (bucket *tokenBucket) cancel(rate Rate) {
+	bucket.tokens++
+	if bucket.tokens > float64(rate.N) {
+		bucket.tokens = float64(rate.N)
+	}
+}
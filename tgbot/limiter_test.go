@@ -0,0 +1,164 @@
+package tgbot
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestLimiter() (*ChatLimiter, *fakeClock) {
+	limiter := NewChatLimiter()
+	clock := newFakeClock()
+	limiter.clock = clock
+	return limiter, clock
+}
+
+func TestChatLimiterPrivateChat(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": Integer(42)}); err != nil {
+			t.Fatal("limiter.Wait() failed: " + err.Error())
+		}
+	}
+
+	sleeps := clock.Sleeps()
+	if len(sleeps) != 2 || sleeps[0] != time.Second || sleeps[1] != time.Second {
+		t.Fatalf("Private chat should be limited to 1 message per second, slept %v", sleeps)
+	}
+
+	// another chat has its own bucket
+	limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": 43})
+	if len(clock.Sleeps()) != 2 {
+		t.Fatalf("Chats should not share buckets, slept %v", clock.Sleeps())
+	}
+}
+
+func TestChatLimiterGroupChat(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	limiter.Global = Rate{}
+	for _, chatID := range []interface{}{Integer(-100123), "-100456", "@channel"} {
+		for i := 0; i < 21; i++ {
+			limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": chatID})
+		}
+	}
+
+	sleeps := clock.Sleeps()
+	if len(sleeps) != 3 || sleeps[0] != 3*time.Second || sleeps[2] != 3*time.Second {
+		t.Fatalf("Group chat should be limited to 20 messages per minute, slept %v", sleeps)
+	}
+}
+
+func TestChatLimiterGlobal(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	for chatID := 1; chatID <= 31; chatID++ {
+		limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": chatID})
+	}
+
+	sleeps := clock.Sleeps()
+	if len(sleeps) != 1 || sleeps[0] != time.Second/30 {
+		t.Fatalf("Bot should be limited to 30 messages per second, slept %v", sleeps)
+	}
+
+	// no chat_id, not throttled
+	limiter.Wait(context.Background(), "getUpdates", Params{})
+	if len(clock.Sleeps()) != 1 {
+		t.Fatal("Requests without chat_id should not be throttled")
+	}
+}
+
+func TestChatLimiterSendMethods(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	for i := 0; i < 31; i++ {
+		limiter.Wait(context.Background(), "getChatMember", Params{"chat_id": Integer(-100123), "user_id": 1})
+		limiter.Wait(context.Background(), "sendChatAction", Params{"chat_id": 1})
+	}
+	if len(clock.Sleeps()) != 0 {
+		t.Fatalf("Only message sending methods should be throttled, slept %v", clock.Sleeps())
+	}
+
+	for i := 0; i < 2; i++ {
+		limiter.Wait(context.Background(), "forwardMessage", Params{"chat_id": 1, "from_chat_id": 2, "message_id": 3})
+	}
+	if len(clock.Sleeps()) != 1 {
+		t.Fatalf("forwardMessage should be throttled, slept %v", clock.Sleeps())
+	}
+}
+
+func TestChatLimiterRefill(t *testing.T) {
+	limiter, clock := newTestLimiter()
+	limiter.Global = Rate{}
+	limiter.PrivateChat = Rate{N: 2, Per: time.Second}
+
+	for i := 0; i < 2; i++ {
+		limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": 1})
+	}
+	clock.Sleep(context.Background(), 10*time.Second)
+
+	// bucket is refilled up to N only
+	for i := 0; i < 3; i++ {
+		limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": 1})
+	}
+
+	sleeps := clock.Sleeps()
+	if len(sleeps) != 2 || sleeps[1] != 500*time.Millisecond {
+		t.Fatalf("Unexpected sleeps: %v", sleeps)
+	}
+}
+
+func TestChatLimiterCancel(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	limiter.clock = nil
+	limiter.PrivateChat = Rate{N: 1, Per: time.Hour}
+
+	limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, "sendMessage", Params{"chat_id": 1}); err == nil {
+		t.Fatal("limiter.Wait() should've failed when ctx is done")
+	}
+
+	// cancelled reservation is returned
+	if delay := limiter.chats["1"].reserve(limiter.PrivateChat, time.Now()); delay > time.Hour {
+		t.Fatalf("Cancelled reservation was not returned, delay %v", delay)
+	}
+}
+
+func TestChatLimiterCancelGlobal(t *testing.T) {
+	limiter, _ := newTestLimiter()
+	limiter.clock = nil
+	limiter.Global = Rate{N: 1, Per: time.Hour}
+	limiter.PrivateChat = Rate{N: 1, Per: time.Hour}
+
+	limiter.Wait(context.Background(), "sendMessage", Params{"chat_id": 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, "sendMessage", Params{"chat_id": 2}); err == nil {
+		t.Fatal("limiter.Wait() should've failed when ctx is done")
+	}
+
+	// chat token of request cancelled on the global bucket is returned
+	if delay := limiter.chats["2"].reserve(limiter.PrivateChat, time.Now()); delay > 0 {
+		t.Fatalf("Chat token was not returned, delay %v", delay)
+	}
+}
+
+func TestBotLimiter(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 200, testMessageResponse)
+	})
+	limiter, clock := newTestLimiter()
+	bot.Limiter = limiter
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := bot.SendMessage(context.Background(), Params{"chat_id": 1, "text": "hi"}); err != nil {
+			t.Fatal("bot.SendMessage() failed: " + err.Error())
+		}
+	}
+
+	if len(clock.Sleeps()) != 1 {
+		t.Fatalf("Bot requests should pass the limiter, slept %v", clock.Sleeps())
+	}
+}
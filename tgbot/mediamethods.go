@@ -0,0 +1,126 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+)
+
+// SendOptions optional params common for send* methods
+type SendOptions struct {
+	DisableNotification bool        `json:"disable_notification,omitempty"` // Optional. Sends the message silently. Users will receive a notification with no sound.
+	ReplyToMessageID    Integer     `json:"reply_to_message_id,omitempty"`  // Optional. If the message is a reply, ID of the original message
+	ReplyMarkup         interface{} `json:"reply_markup,omitempty"`         // Optional. InlineKeyboardMarkup, ReplyKeyboardMarkup, ReplyKeyboardRemove or ForceReply
+}
+
+// SendPhotoParams https://core.telegram.org/bots/api#sendphoto
+type SendPhotoParams struct {
+	ChatID interface{} `json:"chat_id"` // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	Photo  *InputFile  `json:"photo"`   // Photo to send: file_id, HTTP URL or upload
+
+	// Optional
+	Caption string `json:"caption,omitempty"` // Optional. Photo caption (may also be used when resending photos by file_id), 0-200 characters
+	SendOptions
+}
+
+// SendAudioParams https://core.telegram.org/bots/api#sendaudio
+type SendAudioParams struct {
+	ChatID interface{} `json:"chat_id"` // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	Audio  *InputFile  `json:"audio"`   // Audio file to send (.mp3): file_id, HTTP URL or upload
+
+	// Optional
+	Caption   string  `json:"caption,omitempty"`   // Optional. Audio caption, 0-200 characters
+	Duration  Integer `json:"duration,omitempty"`  // Optional. Duration of the audio in seconds
+	Performer string  `json:"performer,omitempty"` // Optional. Performer
+	Title     string  `json:"title,omitempty"`     // Optional. Track name
+	SendOptions
+}
+
+// SendDocumentParams https://core.telegram.org/bots/api#senddocument
+type SendDocumentParams struct {
+	ChatID   interface{} `json:"chat_id"`  // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	Document *InputFile  `json:"document"` // File to send: file_id, HTTP URL or upload
+
+	// Optional
+	Caption string `json:"caption,omitempty"` // Optional. Document caption (may also be used when resending documents by file_id), 0-200 characters
+	SendOptions
+}
+
+// SendVideoParams https://core.telegram.org/bots/api#sendvideo
+type SendVideoParams struct {
+	ChatID interface{} `json:"chat_id"` // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	Video  *InputFile  `json:"video"`   // Video to send (.mp4): file_id, HTTP URL or upload
+
+	// Optional
+	Duration Integer `json:"duration,omitempty"` // Optional. Duration of sent video in seconds
+	Width    Integer `json:"width,omitempty"`    // Optional. Video width
+	Height   Integer `json:"height,omitempty"`   // Optional. Video height
+	Caption  string  `json:"caption,omitempty"`  // Optional. Video caption (may also be used when resending videos by file_id), 0-200 characters
+	SendOptions
+}
+
+// SendVoiceParams https://core.telegram.org/bots/api#sendvoice
+type SendVoiceParams struct {
+	ChatID interface{} `json:"chat_id"` // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	Voice  *InputFile  `json:"voice"`   // Audio file to send (.ogg encoded with OPUS): file_id, HTTP URL or upload
+
+	// Optional
+	Caption  string  `json:"caption,omitempty"`  // Optional. Voice message caption, 0-200 characters
+	Duration Integer `json:"duration,omitempty"` // Optional. Duration of the voice message in seconds
+	SendOptions
+}
+
+// SendVideoNoteParams https://core.telegram.org/bots/api#sendvideonote
+type SendVideoNoteParams struct {
+	ChatID    interface{} `json:"chat_id"`    // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	VideoNote *InputFile  `json:"video_note"` // Video note to send: file_id or upload. Sending video notes by a URL is currently unsupported
+
+	// Optional
+	Duration Integer `json:"duration,omitempty"` // Optional. Duration of sent video in seconds
+	Length   Integer `json:"length,omitempty"`   // Optional. Video width and height
+	SendOptions
+}
+
+// callMessage call method that returns sent Message
+func (bot *Bot) callMessage(ctx context.Context, methodName string, params Params) (*Message, int, error) {
+	response, status, err := bot.Call(ctx, methodName, params)
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	message, err := response.GetResultMessage()
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	return message, status, nil
+}
+
+// SendPhoto https://core.telegram.org/bots/api#sendphoto
+func (bot *Bot) SendPhoto(ctx context.Context, params SendPhotoParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendPhoto", paramsOf(params))
+}
+
+// SendAudio https://core.telegram.org/bots/api#sendaudio
+func (bot *Bot) SendAudio(ctx context.Context, params SendAudioParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendAudio", paramsOf(params))
+}
+
+// SendDocument https://core.telegram.org/bots/api#senddocument
+func (bot *Bot) SendDocument(ctx context.Context, params SendDocumentParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendDocument", paramsOf(params))
+}
+
+// SendVideo https://core.telegram.org/bots/api#sendvideo
+func (bot *Bot) SendVideo(ctx context.Context, params SendVideoParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendVideo", paramsOf(params))
+}
+
+// SendVoice https://core.telegram.org/bots/api#sendvoice
+func (bot *Bot) SendVoice(ctx context.Context, params SendVoiceParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendVoice", paramsOf(params))
+}
+
+// SendVideoNote https://core.telegram.org/bots/api#sendvideonote
+func (bot *Bot) SendVideoNote(ctx context.Context, params SendVideoNoteParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendVideoNote", paramsOf(params))
+}
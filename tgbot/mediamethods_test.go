@@ -0,0 +1,84 @@
+package tgbot
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBotSendPhotoUpload(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/sendPhoto") {
+			t.Errorf("Unexpected path: %v", r.URL.Path)
+		}
+		file, header, err := r.FormFile("photo")
+		if err != nil {
+			t.Errorf("No photo uploaded: %v", err)
+			return
+		}
+		content, _ := ioutil.ReadAll(file)
+		if header.Filename != "cat.jpg" || string(content) != "jpeg" {
+			t.Errorf("Unexpected upload %v: %s", header.Filename, content)
+		}
+		if r.FormValue("caption") != "Cat" || r.FormValue("chat_id") != "42" || r.FormValue("reply_to_message_id") != "" {
+			t.Errorf("Unexpected form: %v", r.Form)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"message_id":5,"date":0,"chat":{"id":42,"type":"private"},"photo":[{"file_id":"AgAD","width":1,"height":1}]}}`)
+	})
+
+	message, _, err := bot.SendPhoto(context.Background(), SendPhotoParams{
+		ChatID:  Integer(42),
+		Photo:   FileBytes("cat.jpg", []byte("jpeg")),
+		Caption: "Cat",
+	})
+	if err != nil {
+		t.Fatal("bot.SendPhoto() failed: " + err.Error())
+	}
+
+	if message.ID != 5 || len(message.Photo) != 1 || message.Photo[0].FileID != "AgAD" {
+		t.Fatalf("Unexpected message: %+v", message)
+	}
+}
+
+func TestBotSendVideoByFileID(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Errorf("file_id should be sent URL-encoded, got %v", r.Header.Get("Content-Type"))
+		}
+		r.ParseForm()
+		expected := map[string]string{
+			"chat_id":              "@channel",
+			"video":                "BAADBAAD",
+			"duration":             "12",
+			"disable_notification": "true",
+			"reply_to_message_id":  "7",
+			"reply_markup":         `{"inline_keyboard":[[{"text":"Open","url":"https://example.com"}]]}`,
+		}
+		for key, value := range expected {
+			if r.PostForm.Get(key) != value {
+				t.Errorf("Unexpected %v: %v", key, r.PostForm.Get(key))
+			}
+		}
+		if len(r.PostForm) != len(expected) {
+			t.Errorf("Unexpected form: %v", r.PostForm)
+		}
+		writeTestResponse(w, 200, testMessageResponse)
+	})
+
+	url := "https://example.com"
+	_, _, err := bot.SendVideo(context.Background(), SendVideoParams{
+		ChatID:   "@channel",
+		Video:    FileID("BAADBAAD"),
+		Duration: 12,
+		SendOptions: SendOptions{
+			DisableNotification: true,
+			ReplyToMessageID:    7,
+			ReplyMarkup:         InlineKeyboardMarkup{[][]InlineKeyboardButton{{{Text: "Open", URL: &url}}}},
+		},
+	})
+	if err != nil {
+		t.Fatal("bot.SendVideo() failed: " + err.Error())
+	}
+}
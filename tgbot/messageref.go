@@ -0,0 +1,65 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MessageRef target message of edit and game methods: ChatID with MessageID, or InlineMessageID for messages sent via inline mode
+type MessageRef struct {
+	ChatID          interface{} `json:"chat_id,omitempty"`           // Required if InlineMessageID is not specified. Unique identifier for the target chat (Integer) or username of the target channel (string)
+	MessageID       Integer     `json:"message_id,omitempty"`        // Required if InlineMessageID is not specified. Identifier of the target message
+	InlineMessageID string      `json:"inline_message_id,omitempty"` // Required if ChatID and MessageID are not specified. Identifier of the inline message
+}
+
+// ChatMessage MessageRef to message of chat
+func ChatMessage(chatID interface{}, messageID Integer) MessageRef {
+	return MessageRef{ChatID: chatID, MessageID: messageID}
+}
+
+// InlineMessage MessageRef to message sent via inline mode
+func InlineMessage(inlineMessageID string) MessageRef {
+	return MessageRef{InlineMessageID: inlineMessageID}
+}
+
+// IsInline true if ref targets inline message
+func (ref MessageRef) IsInline() bool {
+	return len(ref.InlineMessageID) > 0
+}
+
+func (ref MessageRef) validate() error {
+	chatMessage := ref.ChatID != nil || ref.MessageID != 0
+	switch {
+	case ref.IsInline() && chatMessage:
+		return errors.New("either chat_id with message_id or inline_message_id must be specified, not both")
+	case !ref.IsInline() && (ref.ChatID == nil || ref.MessageID == 0):
+		return errors.New("chat_id with message_id or inline_message_id must be specified")
+	}
+	return nil
+}
+
+// callMessageRef call method targeting ref, that returns edited Message, or True for inline messages (nil Message is returned then)
+func (bot *Bot) callMessageRef(ctx context.Context, methodName string, ref MessageRef, params Params) (*Message, int, error) {
+	if err := ref.validate(); err != nil {
+		return nil, 0, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	response, status, err := bot.Call(ctx, methodName, params)
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	if ref.IsInline() {
+		if _, err = response.GetResultBool(); err != nil {
+			return nil, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+		}
+		return nil, status, nil
+	}
+
+	message, err := response.GetResultMessage()
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+	return message, status, nil
+}
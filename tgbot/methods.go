@@ -1,63 +1,86 @@
 package tgbot
 
-import "errors"
+import (
+	"context"
+	"fmt"
+)
 
 // GetUpdates https://core.telegram.org/bots/api#getupdates
-func GetUpdates(botAPIURL string, params Params) ([]Update, int, error) {
-	response, status, err := Get(botAPIURL, "getUpdates", params)
+func (bot *Bot) GetUpdates(ctx context.Context, params Params) ([]Update, int, error) {
+	response, status, err := bot.Get(ctx, "getUpdates", params)
 	if err != nil {
-		return nil, status, errors.New("tgbot.GetUpdates: " + err.Error())
+		return nil, status, fmt.Errorf("tgbot.GetUpdates: %w", err)
 	}
 
 	updates, err := response.GetResultUpdates()
 	if err != nil {
-		return nil, status, errors.New("tgbot.GetUpdates" + err.Error())
+		return nil, status, fmt.Errorf("tgbot.GetUpdates: %w", err)
 	}
 
 	return updates, status, nil
 }
 
-// GetMe https://core.telegram.org/bots/api#getupdates
-func GetMe(botAPIURL string) (*User, int, error) {
-	response, status, err := Get(botAPIURL, "getMe", Params{})
+// GetMe https://core.telegram.org/bots/api#getme
+func (bot *Bot) GetMe(ctx context.Context) (*User, int, error) {
+	response, status, err := bot.Get(ctx, "getMe", Params{})
 	if err != nil {
-		return nil, status, errors.New("tgbot.GetMe: " + err.Error())
+		return nil, status, fmt.Errorf("tgbot.GetMe: %w", err)
 	}
 
 	user, err := response.GetResultUser()
 	if err != nil {
-		return nil, status, errors.New("tgbot.GetMe" + err.Error())
+		return nil, status, fmt.Errorf("tgbot.GetMe: %w", err)
 	}
 
 	return user, status, nil
 }
 
 // SendMessage https://core.telegram.org/bots/api#sendmessage
-func SendMessage(botAPIURL string, params Params) (*Message, int, error) {
-	response, status, err := Get(botAPIURL, "sendMessage", params)
+func (bot *Bot) SendMessage(ctx context.Context, params Params) (*Message, int, error) {
+	response, status, err := bot.Get(ctx, "sendMessage", params)
 	if err != nil {
-		return nil, status, errors.New("tgbot.sendMessage: " + err.Error())
+		return nil, status, fmt.Errorf("tgbot.sendMessage: %w", err)
 	}
 
 	message, err := response.GetResultMessage()
 	if err != nil {
-		return nil, status, errors.New("tgbot.sendMessage" + err.Error())
+		return nil, status, fmt.Errorf("tgbot.sendMessage: %w", err)
 	}
 
 	return message, status, nil
 }
 
 // GetFile https://core.telegram.org/bots/api#getfile
-func GetFile(botAPIURL, fileID string) (*File, int, error) {
-	response, status, err := Get(botAPIURL, "getFile", Params{"file_id": fileID})
+func (bot *Bot) GetFile(ctx context.Context, fileID string) (*File, int, error) {
+	response, status, err := bot.Get(ctx, "getFile", Params{"file_id": fileID})
 	if err != nil {
-		return nil, status, errors.New("tgbot.getFile: " + err.Error())
+		return nil, status, fmt.Errorf("tgbot.getFile: %w", err)
 	}
 
 	file, err := response.GetResultFile()
 	if err != nil {
-		return nil, status, errors.New("tgbot.getFile" + err.Error())
+		return nil, status, fmt.Errorf("tgbot.getFile: %w", err)
 	}
 
 	return file, status, nil
 }
+
+// GetUpdates https://core.telegram.org/bots/api#getupdates
+func GetUpdates(botAPIURL string, params Params) ([]Update, int, error) {
+	return wrapBot(botAPIURL).GetUpdates(context.Background(), params)
+}
+
+// GetMe https://core.telegram.org/bots/api#getme
+func GetMe(botAPIURL string) (*User, int, error) {
+	return wrapBot(botAPIURL).GetMe(context.Background())
+}
+
+// SendMessage https://core.telegram.org/bots/api#sendmessage
+func SendMessage(botAPIURL string, params Params) (*Message, int, error) {
+	return wrapBot(botAPIURL).SendMessage(context.Background(), params)
+}
+
+// GetFile https://core.telegram.org/bots/api#getfile
+func GetFile(botAPIURL, fileID string) (*File, int, error) {
+	return wrapBot(botAPIURL).GetFile(context.Background(), fileID)
+}
@@ -0,0 +1,139 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Middleware wraps Handler with cross-cutting behavior: logging, recovery, access control, etc.
+type Middleware func(next Handler) Handler
+
+// Chain wrap handler with middlewares, the first one is the outermost
+func Chain(handler Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+type updateContextKey struct{}
+
+// UpdateFromContext update handled by Dispatcher, e.g. inside MessageHandlerFunc
+func UpdateFromContext(ctx context.Context) (*Update, bool) {
+	update, ok := ctx.Value(updateContextKey{}).(*Update)
+	return update, ok
+}
+
+// Recover Middleware turning handler panics into errors, panics are logged with stack if logger is set
+func Recover(logger *log.Logger) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, update *Update) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					if logger != nil {
+						logger.Printf("tgbot.Recover: update %v handler panicked: %v\n%s", update.UpdateID, r, debug.Stack())
+					}
+					err = fmt.Errorf("tgbot.Recover: update %v handler panicked: %v", update.UpdateID, r)
+				}
+			}()
+			return next.HandleUpdate(ctx, update)
+		})
+	}
+}
+
+// Logger Middleware logging every update: id, kind, handling time and error. Nothing is logged if logger is nil.
+func Logger(logger *log.Logger) Middleware {
+	if logger == nil {
+		return func(next Handler) Handler {
+			return next
+		}
+	}
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, update *Update) error {
+			started := time.Now()
+			err := next.HandleUpdate(ctx, update)
+			if err != nil {
+				logger.Printf("Update %v (%v) failed in %v: %v", update.UpdateID, update.Kind(), time.Since(started), err)
+			} else {
+				logger.Printf("Update %v (%v) handled in %v", update.UpdateID, update.Kind(), time.Since(started))
+			}
+			return err
+		})
+	}
+}
+
+// AllowUsers Middleware passing only updates sent by users with userIDs, other updates are dropped
+func AllowUsers(userIDs ...Integer) Middleware {
+	allowed := map[Integer]bool{}
+	for _, userID := range userIDs {
+		allowed[userID] = true
+	}
+	return Filter(func(ctx context.Context, update *Update) bool {
+		sender := update.Sender()
+		return sender != nil && allowed[sender.ID]
+	})
+}
+
+// AllowChats Middleware passing only updates from chats with chatIDs, other updates are dropped
+func AllowChats(chatIDs ...Integer) Middleware {
+	allowed := map[Integer]bool{}
+	for _, chatID := range chatIDs {
+		allowed[chatID] = true
+	}
+	return Filter(func(ctx context.Context, update *Update) bool {
+		chat := update.Chat()
+		return chat != nil && allowed[chat.ID]
+	})
+}
+
+// Filter Middleware passing only updates matching pass, other updates are dropped
+func Filter(pass func(ctx context.Context, update *Update) bool) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, update *Update) error {
+			if !pass(ctx, update) {
+				return nil
+			}
+			return next.HandleUpdate(ctx, update)
+		})
+	}
+}
+
+// WithValue Middleware attaching value(ctx, update) to update context under key, e.g. user's locale
+func WithValue(key interface{}, value func(ctx context.Context, update *Update) interface{}) Middleware {
+	return func(next Handler) Handler {
+		return HandlerFunc(func(ctx context.Context, update *Update) error {
+			return next.HandleUpdate(context.WithValue(ctx, key, value(ctx, update)), update)
+		})
+	}
+}
+
+// Deduplicate Middleware dropping updates whose update_id was seen among the last window updates,
+// e.g. webhook updates redelivered by Telegram after a slow response. Window less than 1 is treated as 1.
+func Deduplicate(window int) Middleware {
+	if window < 1 {
+		window = 1
+	}
+	var mutex sync.Mutex
+	seen := map[Integer]bool{}
+	order := make([]Integer, 0, window)
+
+	return Filter(func(ctx context.Context, update *Update) bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if seen[update.UpdateID] {
+			return false
+		}
+		if len(order) == window {
+			delete(seen, order[0])
+			order = order[1:]
+		}
+		seen[update.UpdateID] = true
+		order = append(order, update.UpdateID)
+		return true
+	})
+}
@@ -0,0 +1,126 @@
+package tgbot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+)
+
+func TestDispatcherMiddlewares(t *testing.T) {
+	type localeKey struct{}
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return HandlerFunc(func(ctx context.Context, update *Update) error {
+				calls = append(calls, name)
+				return next.HandleUpdate(ctx, update)
+			})
+		}
+	}
+
+	d := NewDispatcher()
+	d.Use(record("outer"), record("inner"))
+	d.Use(WithValue(localeKey{}, func(ctx context.Context, update *Update) interface{} {
+		return *update.Sender().LanguageCode
+	}))
+	d.OnMessage(func(ctx context.Context, message *Message) error {
+		update, ok := UpdateFromContext(ctx)
+		if !ok || update.Message != message {
+			t.Error("Update is not available in handler context")
+		}
+		calls = append(calls, "handler:"+ctx.Value(localeKey{}).(string))
+		return nil
+	})
+
+	language := "en"
+	update := &Update{Message: &Message{From: &User{ID: 1, LanguageCode: &language}}}
+	if err := d.HandleUpdate(context.Background(), update); err != nil {
+		t.Fatal("d.HandleUpdate() failed: " + err.Error())
+	}
+
+	if strings.Join(calls, " ") != "outer inner handler:en" {
+		t.Fatalf("Unexpected calls: %v", calls)
+	}
+}
+
+func TestRecoverAndLogger(t *testing.T) {
+	var logs bytes.Buffer
+	logger := log.New(&logs, "", 0)
+	handler := Chain(HandlerFunc(func(ctx context.Context, update *Update) error {
+		panic("handler bug")
+	}), Logger(logger), Recover(nil))
+
+	err := handler.HandleUpdate(context.Background(), &Update{UpdateID: 5, Message: &Message{}})
+	if err == nil || !strings.Contains(err.Error(), "handler bug") {
+		t.Fatalf("Panic should be turned into error, got %v", err)
+	}
+
+	if !strings.Contains(logs.String(), "Update 5 (message) failed") {
+		t.Fatal("Unexpected log: " + logs.String())
+	}
+
+	handler = Chain(HandlerFunc(func(ctx context.Context, update *Update) error {
+		return nil
+	}), Logger(nil))
+	if err = handler.HandleUpdate(context.Background(), &Update{UpdateID: 6}); err != nil {
+		t.Fatal("Logger(nil) should pass updates through, got ", err)
+	}
+}
+
+func TestAllowUsersAndChats(t *testing.T) {
+	handled := 0
+	handler := Chain(HandlerFunc(func(ctx context.Context, update *Update) error {
+		handled++
+		return nil
+	}), AllowUsers(1, 2), AllowChats(-100))
+
+	updates := []*Update{
+		{Message: &Message{From: &User{ID: 1}, Chat: Chat{ID: -100}}},
+		{CallbackQuery: &CallbackQuery{From: User{ID: 2}, Message: &Message{Chat: Chat{ID: -100}}}},
+		{Message: &Message{From: &User{ID: 3}, Chat: Chat{ID: -100}}},
+		{Message: &Message{From: &User{ID: 1}, Chat: Chat{ID: -200}}},
+		{ChannelPost: &Message{Chat: Chat{ID: -100}}},
+	}
+	for _, update := range updates {
+		handler.HandleUpdate(context.Background(), update)
+	}
+
+	if handled != 2 {
+		t.Fatalf("Only allowed users in allowed chats should pass, %v passed", handled)
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	var handled []Integer
+	handler := Chain(HandlerFunc(func(ctx context.Context, update *Update) error {
+		handled = append(handled, update.UpdateID)
+		return nil
+	}), Deduplicate(2))
+
+	for _, id := range []Integer{1, 2, 1, 3, 1} {
+		handler.HandleUpdate(context.Background(), &Update{UpdateID: id})
+	}
+	if fmt.Sprint(handled) != "[1 2 3 1]" {
+		t.Fatalf("Unexpected handled updates %v", handled)
+	}
+}
+
+func TestDeduplicateWindow(t *testing.T) {
+	for _, window := range []int{0, -1} {
+		var handled []Integer
+		handler := Chain(HandlerFunc(func(ctx context.Context, update *Update) error {
+			handled = append(handled, update.UpdateID)
+			return nil
+		}), Deduplicate(window))
+
+		for _, id := range []Integer{1, 1, 2, 1} {
+			handler.HandleUpdate(context.Background(), &Update{UpdateID: id})
+		}
+		if fmt.Sprint(handled) != "[1 2 1]" {
+			t.Fatalf("Window %v should be treated as 1, handled updates %v", window, handled)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OffsetStore persists getUpdates offset, so polling resumes where it stopped after restart, see Bot.OffsetStore
+type OffsetStore interface {
+	LoadOffset(ctx context.Context) (Integer, error) // 0 if offset was never saved
+	SaveOffset(ctx context.Context, offset Integer) error
+}
+
+// MemoryOffsetStore OffsetStore keeping offset in memory, offset is lost on restart
+type MemoryOffsetStore struct {
+	mutex  sync.Mutex
+	offset Integer
+}
+
+// LoadOffset implements OffsetStore
+func (store *MemoryOffsetStore) LoadOffset(ctx context.Context) (Integer, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return store.offset, nil
+}
+
+// SaveOffset implements OffsetStore
+func (store *MemoryOffsetStore) SaveOffset(ctx context.Context, offset Integer) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.offset = offset
+	return nil
+}
+
+// FileOffsetStore OffsetStore keeping offset in a local file as decimal number.
+// The file is replaced atomically, so it holds either the old or the new offset after a crash.
+type FileOffsetStore struct {
+	Path string
+
+	mutex sync.Mutex
+}
+
+// NewFileOffsetStore create FileOffsetStore at path, the file is created on the first save
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{Path: path}
+}
+
+// LoadOffset implements OffsetStore
+func (store *FileOffsetStore) LoadOffset(ctx context.Context) (Integer, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	data, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("tgbot.FileOffsetStore: %w", err)
+	}
+
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("tgbot.FileOffsetStore: %v: %w", store.Path, err)
+	}
+	return Integer(offset), nil
+}
+
+// SaveOffset implements OffsetStore
+func (store *FileOffsetStore) SaveOffset(ctx context.Context, offset Integer) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if err := writeFileAtomic(store.Path, []byte(strconv.FormatInt(int64(offset), 10)+"\n")); err != nil {
+		return fmt.Errorf("tgbot.FileOffsetStore: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFileOffsetStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "offset")
+
+	offset, err := NewFileOffsetStore(path).LoadOffset(ctx)
+	if err != nil || offset != 0 {
+		t.Fatal("LoadOffset of missing file failed: ", offset, err)
+	}
+
+	if err = NewFileOffsetStore(path).SaveOffset(ctx, 42); err != nil {
+		t.Fatal("SaveOffset failed: " + err.Error())
+	}
+
+	offset, err = NewFileOffsetStore(path).LoadOffset(ctx)
+	if err != nil || offset != 42 {
+		t.Fatal("LoadOffset failed: ", offset, err)
+	}
+}
+
+func TestBotPollUpdatesOffsetStore(t *testing.T) {
+	var offsets []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		writeTestResponse(w, 200, `{"ok":true,"result":[{"update_id":11},{"update_id":12}]}`)
+	})
+	store := &MemoryOffsetStore{}
+	store.SaveOffset(context.Background(), 11)
+	bot.OffsetStore = store
+
+	_, _, err := bot.PollUpdatesCB(context.Background(), Params{}, func(updates []Update, offset Integer) (Integer, bool) {
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+		}
+		return offset, false
+	})
+	if err != nil {
+		t.Fatal("bot.PollUpdatesCB() failed: " + err.Error())
+	}
+
+	if len(offsets) == 0 || offsets[0] != "11" {
+		t.Fatalf("Stored offset is not used: %v", offsets)
+	}
+	if offset, _ := store.LoadOffset(context.Background()); offset != 13 {
+		t.Fatalf("Offset is not saved: %v", offset)
+	}
+}
+
+func TestBotPollUpdatesHandlerCrash(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		var updates []string
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		for id := 10; id <= 12; id++ {
+			if id >= offset {
+				updates = append(updates, fmt.Sprintf(`{"update_id":%v}`, id))
+			}
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":[`+strings.Join(updates, ",")+`]}`)
+	})
+	store := &MemoryOffsetStore{}
+	bot.OffsetStore = store
+
+	var handled []Integer
+	poll := func(crashOn Integer) {
+		defer func() {
+			recover()
+		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		bot.PollUpdatesHandler(ctx, Params{}, HandlerFunc(func(ctx context.Context, update *Update) error {
+			if update.UpdateID == crashOn {
+				panic("crash")
+			}
+			handled = append(handled, update.UpdateID)
+			if update.UpdateID == 12 {
+				cancel()
+			}
+			return nil
+		}))
+	}
+
+	// crash in the middle of batch, then restart
+	poll(11)
+	poll(0)
+
+	if fmt.Sprint(handled) != "[10 11 12]" {
+		t.Fatalf("Updates handled before crash should not be redelivered, handled %v", handled)
+	}
+}
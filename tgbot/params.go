@@ -4,10 +4,16 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"mime/multipart"
 	"net/url"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Params params for telegram Bot API methods
@@ -22,24 +28,94 @@ func reflectData(v interface{}) reflect.Value {
 	return rv
 }
 
+// paramsOf convert typed params struct to Params using json tags of its fields.
+// Fields tagged omitempty are skipped if zero, embedded structs are flattened, time.Time fields are converted to unix time.
+func paramsOf(v interface{}) Params {
+	params := Params{}
+	addStructParams(params, reflectData(v))
+	return params
+}
+
+func addStructParams(params Params, rv reflect.Value) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field, value := rt.Field(i), rv.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			addStructParams(params, value)
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" || field.PkgPath != "" {
+			continue
+		}
+		name, options := tag, ""
+		if comma := strings.Index(tag, ","); comma >= 0 {
+			name, options = tag[:comma], tag[comma+1:]
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if value.IsZero() && (strings.Contains(options, "omitempty") || value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+			continue
+		}
+		if date, ok := value.Interface().(time.Time); ok {
+			params[name] = Integer(date.Unix())
+			continue
+		}
+		params[name] = value.Interface()
+	}
+}
+
+// paramString string representation of param: strings as is, InputFile as file_id or URL, time.Time as unix time,
+// other values JSON-marshaled
+func paramString(value interface{}) (string, error) {
+	if date, ok := value.(time.Time); ok {
+		return strconv.FormatInt(date.Unix(), 10), nil
+	}
+	if file, ok := asInputFile(value); ok {
+		if file.IsUpload() {
+			return "", errors.New(file.name + " should be uploaded with multipart/form-data")
+		}
+		return file.ref, nil
+	}
+
+	reflectData := reflectData(value)
+	if reflectData.Kind() == reflect.String {
+		return reflectData.String(), nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
 // URLValues convert Params to url.Values, non-string values are JSON-marshaled
 func (params *Params) URLValues() (url.Values, error) {
 	values := url.Values{}
 	for key, value := range *params {
-		reflectData := reflectData(value)
-		if reflectData.Kind() == reflect.String {
-			values.Add(key, reflectData.String())
-		} else {
-			data, err := json.Marshal(value)
-			if err != nil {
-				return url.Values{}, errors.New("tgbotapi.Params.URLValues: " + err.Error())
-			}
-			values.Add(key, string(data))
+		str, err := paramString(value)
+		if err != nil {
+			return url.Values{}, fmt.Errorf("tgbotapi.Params.URLValues: %w", err)
 		}
+		values.Add(key, str)
 	}
 	return values, nil
 }
 
+// HasUploads true if params contain InputFile that should be uploaded
+func (params *Params) HasUploads() bool {
+	for _, value := range *params {
+		if file, ok := asInputFile(value); ok && file.IsUpload() {
+			return true
+		}
+	}
+	return false
+}
+
 // URLEncode URL-encode params, returns io.Reader
 func (params *Params) URLEncode() (io.Reader, error) {
 	values, err := params.URLValues()
@@ -58,7 +134,81 @@ func (params *Params) JSONEncode() (io.Reader, error) {
 	return bytes.NewReader(data), nil
 }
 
-// MultipartFormEncode encode params as multipart/form-data, returns io.Reader
-func (params *Params) MultipartFormEncode() (io.Reader, error) {
-	return nil, nil
+// MultipartFormEncode encode params as multipart/form-data, returns io.Reader and Content-Type with boundary.
+// Non-file values are encoded as in URLValues, uploads are streamed through io.Pipe.
+// The reader should be read until EOF or closed (it is an io.ReadCloser) to release uploaded files.
+func (params *Params) MultipartFormEncode() (io.Reader, string, error) {
+	boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+	contentReader, err := params.multipartForm(boundary)
+	if err != nil {
+		return nil, "", fmt.Errorf("tgbotapi.Params.MultipartFormEncode: %w", err)
+	}
+	return contentReader, "multipart/form-data; boundary=" + boundary, nil
+}
+
+// multipartForm open uploads and start streaming multipart/form-data with boundary
+func (params *Params) multipartForm(boundary string) (io.ReadCloser, error) {
+	keys := make([]string, 0, len(*params))
+	for key := range *params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// encode fields and open files before streaming to fail early
+	fields := map[string]string{}
+	files := map[string]io.ReadCloser{}
+	closeFiles := func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}
+	for _, key := range keys {
+		value := (*params)[key]
+		if file, ok := asInputFile(value); ok && file.IsUpload() {
+			content, err := file.open()
+			if err != nil {
+				closeFiles()
+				return nil, err
+			}
+			files[key] = content
+			continue
+		}
+
+		str, err := paramString(value)
+		if err != nil {
+			closeFiles()
+			return nil, err
+		}
+		fields[key] = str
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		defer closeFiles()
+		form := multipart.NewWriter(writer)
+		form.SetBoundary(boundary)
+		writer.CloseWithError(params.writeMultipartForm(form, keys, fields, files))
+	}()
+	return reader, nil
+}
+
+func (params *Params) writeMultipartForm(form *multipart.Writer, keys []string, fields map[string]string, files map[string]io.ReadCloser) error {
+	for _, key := range keys {
+		if content, ok := files[key]; ok {
+			file, _ := asInputFile((*params)[key])
+			part, err := form.CreateFormFile(key, file.name)
+			if err != nil {
+				return err
+			}
+			if _, err = io.Copy(part, content); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := form.WriteField(key, fields[key]); err != nil {
+			return err
+		}
+	}
+	return form.Close()
 }
@@ -1,8 +1,13 @@
 package tgbot
 
 import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestParamsURLValuesOk(t *testing.T) {
@@ -18,3 +23,99 @@ func TestParamsURLValuesOk(t *testing.T) {
 		t.Fatal("Unexpected Representation of Url-Encoded Values: \"" + encodedParams + "\"")
 	}
 }
+
+func TestParamsURLValuesInputFile(t *testing.T) {
+	params := Params{"photo": FileID("AgADBAADq6cxG"), "document": FileURL("https://example.com/a.pdf")}
+	values, err := params.URLValues()
+	if err != nil {
+		t.Fatal("params.URLValues() failed: " + err.Error())
+	}
+
+	if values.Get("photo") != "AgADBAADq6cxG" || values.Get("document") != "https://example.com/a.pdf" {
+		t.Fatal("Unexpected file references: " + values.Encode())
+	}
+
+	uploads := Params{"photo": FileBytes("a.jpg", []byte("jpeg"))}
+	if _, err = uploads.URLValues(); err == nil || !uploads.HasUploads() || params.HasUploads() {
+		t.Fatal("Uploads should not be URL-encoded")
+	}
+}
+
+func TestParamsMultipartFormEncode(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "video.mp4")
+	if err := ioutil.WriteFile(fname, []byte("mp4 content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	str := "abc def"
+	params := Params{
+		"chat_id":      Integer(42),
+		"caption":      &str,
+		"reply_markup": ForceReply{ForceReply: true},
+		"video":        FilePath(fname),
+		"thumb":        FileReader("thumb.jpg", strings.NewReader("jpeg content")),
+		"audio":        FileID("AwADBAADbXXXXXXXXXXXGBdhD2l6_XX"),
+	}
+	contentReader, contentType, err := params.MultipartFormEncode()
+	if err != nil {
+		t.Fatal("params.MultipartFormEncode() failed: " + err.Error())
+	}
+
+	mediaType, mediaParams, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatal("Unexpected Content-Type: " + contentType)
+	}
+
+	form, err := multipart.NewReader(contentReader, mediaParams["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal("Can't read multipart form: " + err.Error())
+	}
+
+	expectedFields := map[string]string{
+		"chat_id":      "42",
+		"caption":      "abc def",
+		"reply_markup": `{"force_reply":true}`,
+		"audio":        "AwADBAADbXXXXXXXXXXXGBdhD2l6_XX",
+	}
+	for key, expected := range expectedFields {
+		if len(form.Value[key]) != 1 || form.Value[key][0] != expected {
+			t.Fatalf("Unexpected %v field: %v", key, form.Value[key])
+		}
+	}
+
+	expectedFiles := map[string][2]string{
+		"video": {"video.mp4", "mp4 content"},
+		"thumb": {"thumb.jpg", "jpeg content"},
+	}
+	for key, expected := range expectedFiles {
+		if len(form.File[key]) != 1 || form.File[key][0].Filename != expected[0] {
+			t.Fatalf("Unexpected %v file: %v", key, form.File[key])
+		}
+		file, _ := form.File[key][0].Open()
+		content, _ := ioutil.ReadAll(file)
+		if string(content) != expected[1] {
+			t.Fatalf("Unexpected %v content: %s", key, content)
+		}
+	}
+}
+
+func TestParamsMultipartFormEncodeMissingFile(t *testing.T) {
+	params := Params{"document": FilePath(filepath.Join(t.TempDir(), "missing.pdf"))}
+	if _, _, err := params.MultipartFormEncode(); err == nil {
+		t.Fatal("params.MultipartFormEncode() should've failed for missing file")
+	}
+}
+
+func TestParamsOfTime(t *testing.T) {
+	params := paramsOf(struct {
+		UntilDate time.Time `json:"until_date,omitempty"`
+		Never     time.Time `json:"never,omitempty"`
+	}{UntilDate: time.Unix(1500000000, 0)})
+
+	if params["until_date"] != Integer(1500000000) || len(params) != 1 {
+		t.Fatalf("Unexpected params: %v", params)
+	}
+	if str, _ := paramString(time.Unix(1500000000, 0)); str != "1500000000" {
+		t.Fatal("Unexpected time param: " + str)
+	}
+}
@@ -0,0 +1,167 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultPaymentAnswerTimeout time PaymentFlow callbacks have to decide.
+// Telegram waits for answer to shipping and pre-checkout queries for 10 seconds, the rest is left for the answer request.
+const DefaultPaymentAnswerTimeout = 8 * time.Second
+
+// DefaultPaymentErrorMessage shown to the user when PaymentFlow callback fails unexpectedly
+const DefaultPaymentErrorMessage = "Sorry, something went wrong. Please try again later."
+
+// PaymentError error of PaymentFlow callbacks shown to the user as is, e.g. "Sorry, we don't deliver to Mars"
+type PaymentError struct {
+	Message string
+}
+
+func (err *PaymentError) Error() string {
+	return err.Message
+}
+
+// PaymentFlow Handler answering shipping and pre-checkout queries with callbacks' decisions within the deadline.
+// A query is declined if its callback fails or doesn't return within AnswerTimeout. Callbacks get ctx with that deadline.
+type PaymentFlow struct {
+	Bot *Bot // Bot used to answer queries
+
+	// Optional
+	OnShippingQuery     func(ctx context.Context, query *ShippingQuery) ([]ShippingOption, error) // Optional. Shipping options for the address, declined if none, all addresses are declined if nil
+	OnPreCheckoutQuery  func(ctx context.Context, query *PreCheckoutQuery) error                  // Optional. Confirms the order, all orders are confirmed if nil
+	OnSuccessfulPayment func(ctx context.Context, message *Message) error                         // Optional. Called on message about successful payment
+
+	AnswerTimeout time.Duration // Optional. DefaultPaymentAnswerTimeout if 0
+	ErrorMessage  string        // Optional. Shown to the user when callback fails with error other than *PaymentError or times out, DefaultPaymentErrorMessage if empty
+}
+
+// NewPaymentFlow create PaymentFlow answering with bot
+func NewPaymentFlow(bot *Bot) *PaymentFlow {
+	return &PaymentFlow{Bot: bot}
+}
+
+// Register register flow in d for shipping queries, pre-checkout queries and successful payment messages.
+// Register it before other message handlers, so they do not receive successful payment messages.
+func (flow *PaymentFlow) Register(d *Dispatcher) {
+	d.Handle(KindShippingQuery, flow)
+	d.Handle(KindPreCheckoutQuery, flow)
+	d.Handle(KindMessage, flow)
+}
+
+// HandleUpdate implements Handler, updates not related to payments are passed through
+func (flow *PaymentFlow) HandleUpdate(ctx context.Context, update *Update) error {
+	switch {
+	case update.ShippingQuery != nil:
+		return flow.handleShippingQuery(ctx, update.ShippingQuery)
+	case update.PreCheckoutQuery != nil:
+		return flow.handlePreCheckoutQuery(ctx, update.PreCheckoutQuery)
+	case update.Message != nil && update.Message.SuccessfulePayment != nil:
+		if flow.OnSuccessfulPayment == nil {
+			return nil
+		}
+		if err := flow.OnSuccessfulPayment(ctx, update.Message); err != nil {
+			return fmt.Errorf("tgbot.PaymentFlow: successful payment: %w", err)
+		}
+		return ErrStopPropagation
+	}
+	return nil
+}
+
+func (flow *PaymentFlow) handleShippingQuery(ctx context.Context, query *ShippingQuery) error {
+	options, err := flow.decide(ctx, func(ctx context.Context) ([]ShippingOption, error) {
+		if flow.OnShippingQuery == nil {
+			return nil, &PaymentError{Message: "Shipping is not available"}
+		}
+		options, err := flow.OnShippingQuery(ctx, query)
+		if err == nil && len(options) == 0 {
+			err = errors.New("no shipping options for accepted address")
+		}
+		return options, err
+	})
+
+	params := AnswerShippingQueryParams{ShippingQueryID: query.ID, OK: err == nil}
+	if err == nil {
+		params.ShippingOptions = options
+	} else {
+		params.ErrorMessage = flow.errorMessage(err)
+	}
+	if _, _, answerErr := flow.Bot.AnswerShippingQuery(ctx, params); answerErr != nil {
+		return fmt.Errorf("tgbot.PaymentFlow: shipping query %v: %w", query.ID, answerErr)
+	}
+	return flow.callbackError("shipping query", query.ID, err)
+}
+
+func (flow *PaymentFlow) handlePreCheckoutQuery(ctx context.Context, query *PreCheckoutQuery) error {
+	_, err := flow.decide(ctx, func(ctx context.Context) ([]ShippingOption, error) {
+		if flow.OnPreCheckoutQuery == nil {
+			return nil, nil
+		}
+		return nil, flow.OnPreCheckoutQuery(ctx, query)
+	})
+
+	params := AnswerPreCheckoutQueryParams{PreCheckoutQueryID: query.ID, OK: err == nil}
+	if err != nil {
+		params.ErrorMessage = flow.errorMessage(err)
+	}
+	if _, _, answerErr := flow.Bot.AnswerPreCheckoutQuery(ctx, params); answerErr != nil {
+		return fmt.Errorf("tgbot.PaymentFlow: pre-checkout query %v: %w", query.ID, answerErr)
+	}
+	return flow.callbackError("pre-checkout query", query.ID, err)
+}
+
+// paymentDecision result of PaymentFlow callback passed from its goroutine
+type paymentDecision struct {
+	options []ShippingOption
+	err     error
+}
+
+// decide run callback with AnswerTimeout, context.DeadlineExceeded is returned if it doesn't return in time.
+// Results of callbacks returning too late are dropped.
+func (flow *PaymentFlow) decide(ctx context.Context, callback func(ctx context.Context) ([]ShippingOption, error)) ([]ShippingOption, error) {
+	timeout := flow.AnswerTimeout
+	if timeout <= 0 {
+		timeout = DefaultPaymentAnswerTimeout
+	}
+	callbackCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := make(chan paymentDecision, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				result <- paymentDecision{err: fmt.Errorf("callback panicked: %v", r)}
+			}
+		}()
+		options, err := callback(callbackCtx)
+		result <- paymentDecision{options: options, err: err}
+	}()
+
+	select {
+	case decision := <-result:
+		return decision.options, decision.err
+	case <-callbackCtx.Done():
+		return nil, callbackCtx.Err()
+	}
+}
+
+func (flow *PaymentFlow) errorMessage(err error) string {
+	var paymentErr *PaymentError
+	if errors.As(err, &paymentErr) {
+		return paymentErr.Message
+	}
+	if len(flow.ErrorMessage) == 0 {
+		return DefaultPaymentErrorMessage
+	}
+	return flow.ErrorMessage
+}
+
+// callbackError error to report after the query is declined, declines with PaymentError are not errors
+func (flow *PaymentFlow) callbackError(kind string, queryID string, err error) error {
+	var paymentErr *PaymentError
+	if err == nil || errors.As(err, &paymentErr) {
+		return ErrStopPropagation
+	}
+	return fmt.Errorf("tgbot.PaymentFlow: %v %v: %w", kind, queryID, err)
+}
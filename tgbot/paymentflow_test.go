@@ -0,0 +1,180 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testPaymentAnswers struct {
+	mutex   sync.Mutex
+	answers map[string]url.Values
+}
+
+func newTestPaymentBot(t *testing.T) (*Bot, *testPaymentAnswers) {
+	answers := &testPaymentAnswers{answers: map[string]url.Values{}}
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		answers.mutex.Lock()
+		answers.answers[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = r.PostForm
+		answers.mutex.Unlock()
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+	return bot, answers
+}
+
+func (answers *testPaymentAnswers) get(methodName string) url.Values {
+	answers.mutex.Lock()
+	defer answers.mutex.Unlock()
+	return answers.answers[methodName]
+}
+
+const testShippingQueryUpdate = `{"update_id":1,"shipping_query":{"id":"sq1","from":{"id":7,"is_bot":false,"first_name":"A"},"invoice_payload":"order-1",
+	"shipping_address":{"country_code":"NL","state":"","city":"Amsterdam","street_line1":"Dam 1","street_line2":"","post_code":"1012"}}}`
+
+const testPreCheckoutQueryUpdate = `{"update_id":2,"pre_checkout_query":{"id":"pq1","from":{"id":7,"is_bot":false,"first_name":"A"},"currency":"USD","total_amount":160,"invoice_payload":"order-1"}}`
+
+func TestPaymentFlowShippingQuery(t *testing.T) {
+	bot, answers := newTestPaymentBot(t)
+	flow := NewPaymentFlow(bot)
+	flow.OnShippingQuery = func(ctx context.Context, query *ShippingQuery) ([]ShippingOption, error) {
+		if query.ShippingAddress.CountryCode != "NL" {
+			return nil, &PaymentError{Message: "We don't deliver there"}
+		}
+		return []ShippingOption{{ID: "post", Title: "Post", Prices: []LabeledPrice{{"Post", 500}}}}, nil
+	}
+
+	update := parseTestUpdate(t, testShippingQueryUpdate)
+	if err := flow.HandleUpdate(context.Background(), update); err != ErrStopPropagation {
+		t.Fatal("Expected ErrStopPropagation, got ", err)
+	}
+	answer := answers.get("answerShippingQuery")
+	if answer.Get("shipping_query_id") != "sq1" || answer.Get("ok") != "true" ||
+		answer.Get("shipping_options") != `[{"id":"post","title":"Post","prices":[{"label":"Post","amount":500}]}]` {
+		t.Fatalf("Unexpected answer: %v", answer)
+	}
+
+	update.ShippingQuery.ShippingAddress.CountryCode = "US"
+	if err := flow.HandleUpdate(context.Background(), update); err != ErrStopPropagation {
+		t.Fatal("Declined query must not fail, got ", err)
+	}
+	answer = answers.get("answerShippingQuery")
+	if answer.Get("ok") != "false" || answer.Get("error_message") != "We don't deliver there" {
+		t.Fatalf("Unexpected answer: %v", answer)
+	}
+}
+
+func TestPaymentFlowPreCheckoutQueryError(t *testing.T) {
+	bot, answers := newTestPaymentBot(t)
+	flow := NewPaymentFlow(bot)
+	flow.OnPreCheckoutQuery = func(ctx context.Context, query *PreCheckoutQuery) error {
+		return errors.New("database is down")
+	}
+
+	err := flow.HandleUpdate(context.Background(), parseTestUpdate(t, testPreCheckoutQueryUpdate))
+	if err == nil || err == ErrStopPropagation || !strings.Contains(err.Error(), "database is down") {
+		t.Fatal("Expected callback error, got ", err)
+	}
+	answer := answers.get("answerPreCheckoutQuery")
+	if answer.Get("pre_checkout_query_id") != "pq1" || answer.Get("ok") != "false" || answer.Get("error_message") != DefaultPaymentErrorMessage {
+		t.Fatalf("Unexpected answer: %v", answer)
+	}
+}
+
+func TestPaymentFlowTimeout(t *testing.T) {
+	bot, answers := newTestPaymentBot(t)
+	flow := NewPaymentFlow(bot)
+	flow.AnswerTimeout = 50 * time.Millisecond
+	flow.ErrorMessage = "Too slow"
+	release := make(chan struct{})
+	defer close(release)
+	flow.OnPreCheckoutQuery = func(ctx context.Context, query *PreCheckoutQuery) error {
+		<-release
+		return nil
+	}
+
+	started := time.Now()
+	err := flow.HandleUpdate(context.Background(), parseTestUpdate(t, testPreCheckoutQueryUpdate))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected context.DeadlineExceeded, got ", err)
+	}
+	if time.Since(started) > 5*time.Second {
+		t.Fatal("PaymentFlow didn't answer within deadline")
+	}
+	if answer := answers.get("answerPreCheckoutQuery"); answer.Get("ok") != "false" || answer.Get("error_message") != "Too slow" {
+		t.Fatalf("Unexpected answer: %v", answer)
+	}
+}
+
+func TestPaymentFlowShippingQueryTimeout(t *testing.T) {
+	bot, answers := newTestPaymentBot(t)
+	flow := NewPaymentFlow(bot)
+	flow.AnswerTimeout = 10 * time.Millisecond
+	flow.OnShippingQuery = func(ctx context.Context, query *ShippingQuery) ([]ShippingOption, error) {
+		<-ctx.Done()
+		return []ShippingOption{{ID: "late", Title: "Late"}}, nil
+	}
+
+	err := flow.HandleUpdate(context.Background(), parseTestUpdate(t, testShippingQueryUpdate))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatal("Expected context.DeadlineExceeded, got ", err)
+	}
+	answer := answers.get("answerShippingQuery")
+	if answer.Get("ok") != "false" || answer.Get("shipping_options") != "" {
+		t.Fatalf("Late shipping options must not be sent: %v", answer)
+	}
+}
+
+func TestPaymentFlowNoShippingOptions(t *testing.T) {
+	bot, answers := newTestPaymentBot(t)
+	flow := NewPaymentFlow(bot)
+	flow.OnShippingQuery = func(ctx context.Context, query *ShippingQuery) ([]ShippingOption, error) {
+		return nil, nil
+	}
+
+	err := flow.HandleUpdate(context.Background(), parseTestUpdate(t, testShippingQueryUpdate))
+	if err == nil || err == ErrStopPropagation {
+		t.Fatal("Expected error of empty shipping options, got ", err)
+	}
+	if answer := answers.get("answerShippingQuery"); answer.Get("ok") != "false" || answer.Get("error_message") != DefaultPaymentErrorMessage {
+		t.Fatalf("Query without shipping options should be declined: %v", answer)
+	}
+}
+
+func TestPaymentFlowSuccessfulPayment(t *testing.T) {
+	bot, _ := newTestPaymentBot(t)
+	flow := NewPaymentFlow(bot)
+	var payload string
+	flow.OnSuccessfulPayment = func(ctx context.Context, message *Message) error {
+		payload = message.SuccessfulePayment.InvoicePayload
+		return nil
+	}
+
+	d := NewDispatcher()
+	flow.Register(d)
+	echoed := false
+	d.OnMessage(func(ctx context.Context, message *Message) error {
+		echoed = true
+		return nil
+	})
+
+	update := parseTestUpdate(t, `{"update_id":3,"message":{"message_id":1,"date":0,"chat":{"id":7,"type":"private"},
+		"successful_payment":{"currency":"USD","total_amount":160,"invoice_payload":"order-1","telegram_payment_charge_id":"t","provider_payment_charge_id":"p"}}}`)
+	if err := d.HandleUpdate(context.Background(), update); err != nil {
+		t.Fatal("d.HandleUpdate() failed: " + err.Error())
+	}
+	if payload != "order-1" || echoed {
+		t.Fatalf("Unexpected payload %q, echoed %v", payload, echoed)
+	}
+	if update.Sender() != nil {
+		t.Fatal("Unexpected sender")
+	}
+	if query := parseTestUpdate(t, testPreCheckoutQueryUpdate); query.Sender() == nil || query.Sender().ID != 7 {
+		t.Fatal("Pre-checkout query sender is unknown")
+	}
+}
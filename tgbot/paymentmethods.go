@@ -0,0 +1,64 @@
+package tgbot
+
+import (
+	"context"
+)
+
+// SendInvoiceParams https://core.telegram.org/bots/api#sendinvoice
+type SendInvoiceParams struct {
+	ChatID         Integer        `json:"chat_id"`         // Unique identifier for the target private chat
+	Title          string         `json:"title"`           // Product name, 1-32 characters
+	Description    string         `json:"description"`     // Product description, 1-255 characters
+	Payload        string         `json:"payload"`         // Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your internal processes.
+	ProviderToken  string         `json:"provider_token"`  // Payments provider token, obtained via Botfather
+	StartParameter string         `json:"start_parameter"` // Unique deep-linking parameter that can be used to generate this invoice when used as a start parameter
+	Currency       string         `json:"currency"`        // Three-letter ISO 4217 currency code
+	Prices         []LabeledPrice `json:"prices"`          // Price breakdown (e.g. product price, tax, discount, delivery cost, delivery tax, bonus, etc.)
+
+	// Optional
+	ProviderData        string  `json:"provider_data,omitempty"`         // Optional. JSON-encoded data about the invoice, which will be shared with the payment provider
+	PhotoURL            string  `json:"photo_url,omitempty"`             // Optional. URL of the product photo for the invoice
+	PhotoSize           Integer `json:"photo_size,omitempty"`            // Optional. Photo size
+	PhotoWidth          Integer `json:"photo_width,omitempty"`           // Optional. Photo width
+	PhotoHeight         Integer `json:"photo_height,omitempty"`          // Optional. Photo height
+	NeedName            bool    `json:"need_name,omitempty"`             // Optional. Pass True, if you require the user's full name to complete the order
+	NeedPhoneNumber     bool    `json:"need_phone_number,omitempty"`     // Optional. Pass True, if you require the user's phone number to complete the order
+	NeedEmail           bool    `json:"need_email,omitempty"`            // Optional. Pass True, if you require the user's email to complete the order
+	NeedShippingAddress bool    `json:"need_shipping_address,omitempty"` // Optional. Pass True, if you require the user's shipping address to complete the order
+	IsFlexible          bool    `json:"is_flexible,omitempty"`           // Optional. Pass True, if the final price depends on the shipping method
+	SendOptions                 // Optional. ReplyMarkup must be InlineKeyboardMarkup with Pay button first, if set
+}
+
+// AnswerShippingQueryParams https://core.telegram.org/bots/api#answershippingquery
+type AnswerShippingQueryParams struct {
+	ShippingQueryID string `json:"shipping_query_id"` // Unique identifier for the query to be answered
+	OK              bool   `json:"ok"`                // Specify True if delivery to the specified address is possible and False if there are any problems
+
+	// Optional
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"` // Optional. Required if ok is True. Available shipping options.
+	ErrorMessage    string           `json:"error_message,omitempty"`    // Optional. Required if ok is False. Error message in human readable form that explains why it is impossible to complete the order
+}
+
+// AnswerPreCheckoutQueryParams https://core.telegram.org/bots/api#answerprecheckoutquery
+type AnswerPreCheckoutQueryParams struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"` // Unique identifier for the query to be answered
+	OK                 bool   `json:"ok"`                    // Specify True if everything is alright (goods are available, etc.) and the bot is ready to proceed with the order
+
+	// Optional
+	ErrorMessage string `json:"error_message,omitempty"` // Optional. Required if ok is False. Error message in human readable form that explains the reason for failure to proceed with the checkout
+}
+
+// SendInvoice https://core.telegram.org/bots/api#sendinvoice
+func (bot *Bot) SendInvoice(ctx context.Context, params SendInvoiceParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendInvoice", paramsOf(params))
+}
+
+// AnswerShippingQuery https://core.telegram.org/bots/api#answershippingquery
+func (bot *Bot) AnswerShippingQuery(ctx context.Context, params AnswerShippingQueryParams) (bool, int, error) {
+	return bot.callBool(ctx, "answerShippingQuery", paramsOf(params))
+}
+
+// AnswerPreCheckoutQuery https://core.telegram.org/bots/api#answerprecheckoutquery
+func (bot *Bot) AnswerPreCheckoutQuery(ctx context.Context, params AnswerPreCheckoutQueryParams) (bool, int, error) {
+	return bot.callBool(ctx, "answerPreCheckoutQuery", paramsOf(params))
+}
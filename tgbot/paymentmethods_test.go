@@ -0,0 +1,58 @@
+package tgbot
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBotSendInvoice(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/sendInvoice") {
+			t.Errorf("Unexpected path: %v", r.URL.Path)
+		}
+		r.ParseForm()
+		expected := map[string]string{
+			"chat_id":         "42",
+			"title":           "Coffee",
+			"description":     "Large cup",
+			"payload":         "order-1",
+			"provider_token":  "TOKEN",
+			"start_parameter": "coffee",
+			"currency":        "USD",
+			"prices":          `[{"label":"Coffee","amount":145},{"label":"Tax","amount":15}]`,
+			"need_email":      "true",
+			"is_flexible":     "true",
+		}
+		for key, value := range expected {
+			if r.PostForm.Get(key) != value {
+				t.Errorf("Unexpected %v: %v", key, r.PostForm.Get(key))
+			}
+		}
+		if len(r.PostForm) != len(expected) {
+			t.Errorf("Unexpected form: %v", r.PostForm)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"message_id":3,"date":0,"chat":{"id":42,"type":"private"},
+			"invoice":{"title":"Coffee","description":"Large cup","start_parameter":"coffee","currency":"USD","total_amount":160}}}`)
+	})
+
+	message, _, err := bot.SendInvoice(context.Background(), SendInvoiceParams{
+		ChatID:         42,
+		Title:          "Coffee",
+		Description:    "Large cup",
+		Payload:        "order-1",
+		ProviderToken:  "TOKEN",
+		StartParameter: "coffee",
+		Currency:       "USD",
+		Prices:         []LabeledPrice{{"Coffee", 145}, {"Tax", 15}},
+		NeedEmail:      true,
+		IsFlexible:     true,
+	})
+	if err != nil {
+		t.Fatal("bot.SendInvoice() failed: " + err.Error())
+	}
+	if message.Invoice == nil || message.Invoice.TotalAmount != 160 {
+		t.Fatalf("Unexpected message: %+v", message)
+	}
+}
@@ -1,44 +1,141 @@
 package tgbot
 
 import (
-	"log"
+	"context"
+	"fmt"
 	"sync/atomic"
 )
 
-// PollUpdatesCB .
-func PollUpdatesCB(botAPIURL string, params Params, handleUpdates func([]Update, Integer) (Integer, bool)) (Integer, int, error) {
+// PollUpdatesCB polls updates and passes them to handleUpdates until it returns false or ctx is done.
+// handleUpdates gets current offset and returns the new one.
+// Returned offset is the last one returned by handleUpdates, pass it as params["offset"] to resume polling.
+// If bot.OffsetStore is set, offset is loaded from it unless params["offset"] is set, and saved after each batch.
+func (bot *Bot) PollUpdatesCB(ctx context.Context, params Params, handleUpdates func([]Update, Integer) (Integer, bool)) (Integer, int, error) {
 	pollNext := true
 	var offset Integer
 	if val, ok := params["offset"]; ok {
 		offset = val.(Integer)
+	} else if bot.OffsetStore != nil {
+		stored, err := bot.OffsetStore.LoadOffset(ctx)
+		if err != nil {
+			return 0, 0, fmt.Errorf("tgbot.PollUpdatesCB: %w", err)
+		}
+		if stored > 0 {
+			offset = stored
+			params["offset"] = offset
+		}
 	}
 
 	for pollNext {
-		updates, status, err := GetUpdates(botAPIURL, params)
-		if status == 239 {
-			log.Println("RateLimit exceeded")
+		if err := ctx.Err(); err != nil {
+			return offset, 0, err
+		}
+
+		updates, status, err := bot.GetUpdates(ctx, params)
+		if ctx.Err() != nil {
+			return offset, status, ctx.Err()
+		} else if IsFloodWait(err) {
+			bot.logf("RateLimit exceeded")
 			return offset, status, err
 		} else if err != nil {
 			return offset, status, err
 		} else {
+			handled := offset
 			offset, pollNext = handleUpdates(updates, offset)
 			params["offset"] = offset
+			if bot.OffsetStore != nil && offset != handled {
+				if err := bot.OffsetStore.SaveOffset(ctx, offset); err != nil {
+					return offset, status, fmt.Errorf("tgbot.PollUpdatesCB: %w", err)
+				}
+			}
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return offset, 0, err
+	}
+
+	// confirm handled updates, so they are not received again
 	params["limit"] = 1
 	params["timeout"] = 0
-	_, status, err := GetUpdates(botAPIURL, params)
+	_, status, err := bot.GetUpdates(ctx, params)
 	return offset, status, err
 }
 
-// PollUpdates .
+// PollUpdates polls updates into output until ctx is done, output is closed on return
+func (bot *Bot) PollUpdates(ctx context.Context, params Params, output chan<- Update) (Integer, int, error) {
+	defer close(output)
+
+	handleUpdates := func(updates []Update, offset Integer) (Integer, bool) {
+		for _, update := range updates {
+			select {
+			case output <- update:
+				offset = update.UpdateID + 1
+			case <-ctx.Done():
+				return offset, false
+			}
+		}
+		return offset, true
+	}
+
+	return bot.PollUpdatesCB(ctx, params, handleUpdates)
+}
+
+// PollUpdatesHandler polls updates and passes them one by one to handler until ctx is done.
+// Update is confirmed after handler returns, handler errors are logged.
+// If bot.OffsetStore is set, offset is saved after each update, so handled updates are not redelivered after a crash.
+func (bot *Bot) PollUpdatesHandler(ctx context.Context, params Params, handler Handler) (Integer, int, error) {
+	handleUpdates := func(updates []Update, offset Integer) (Integer, bool) {
+		for i := range updates {
+			if ctx.Err() != nil {
+				return offset, false
+			}
+			if err := handler.HandleUpdate(ctx, &updates[i]); err != nil {
+				bot.logf("Update %v handler failed: %v", updates[i].UpdateID, err)
+			}
+			offset = updates[i].UpdateID + 1
+			if bot.OffsetStore != nil {
+				if err := bot.OffsetStore.SaveOffset(ctx, offset); err != nil {
+					bot.logf("Saving offset %v failed: %v", offset, err)
+					return offset, false
+				}
+			}
+		}
+		return offset, true
+	}
+
+	return bot.PollUpdatesCB(ctx, params, handleUpdates)
+}
+
+// PollUpdatesPool polls updates and handles each batch with pool until ctx is done.
+// Offset is advanced only after the whole batch is handled, so updates are delivered at least once.
+func (bot *Bot) PollUpdatesPool(ctx context.Context, params Params, pool *WorkerPool) (Integer, int, error) {
+	handleUpdates := func(updates []Update, offset Integer) (Integer, bool) {
+		if err := pool.HandleUpdates(ctx, updates); err != nil {
+			return offset, false
+		}
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+		}
+		return offset, true
+	}
+
+	return bot.PollUpdatesCB(ctx, params, handleUpdates)
+}
+
+// PollUpdatesCB .
+func PollUpdatesCB(botAPIURL string, params Params, handleUpdates func([]Update, Integer) (Integer, bool)) (Integer, int, error) {
+	return wrapBot(botAPIURL).PollUpdatesCB(context.Background(), params, handleUpdates)
+}
+
+// PollUpdates polls updates into output until stop is set. Use Bot.PollUpdates to stop polling with context.
 func PollUpdates(botAPIURL string, params Params, output chan<- Update, stop *int32) (Integer, int, error) {
+	bot := wrapBot(botAPIURL)
 	handleUpdates := func(updates []Update, offset Integer) (Integer, bool) {
 		pstop := stop
 		for _, update := range updates {
 			if atomic.LoadInt32(pstop) > 0 {
-				log.Println("PollUpdates received stop.")
+				bot.logf("PollUpdates received stop.")
 				close(output)
 				return offset, false
 			}
@@ -48,5 +145,5 @@ func PollUpdates(botAPIURL string, params Params, output chan<- Update, stop *in
 		return offset, true
 	}
 
-	return PollUpdatesCB(botAPIURL, params, handleUpdates)
+	return bot.PollUpdatesCB(context.Background(), params, handleUpdates)
 }
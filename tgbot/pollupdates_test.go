@@ -0,0 +1,102 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBotPollUpdatesCB(t *testing.T) {
+	var offsets []string
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		if offset == "" {
+			writeTestResponse(w, 200, `{"ok":true,"result":[{"update_id":10},{"update_id":11}]}`)
+		} else {
+			writeTestResponse(w, 200, `{"ok":true,"result":[]}`)
+		}
+	})
+
+	calls := 0
+	offset, _, err := bot.PollUpdatesCB(context.Background(), Params{}, func(updates []Update, offset Integer) (Integer, bool) {
+		calls++
+		for _, update := range updates {
+			offset = update.UpdateID + 1
+		}
+		return offset, calls < 2
+	})
+	if err != nil {
+		t.Fatal("bot.PollUpdatesCB() failed: " + err.Error())
+	}
+
+	if offset != 12 {
+		t.Fatalf("Unexpected offset: %v", offset)
+	}
+
+	if fmt.Sprint(offsets) != "[ 12 12]" {
+		t.Fatalf("Unexpected requested offsets: %v", offsets)
+	}
+}
+
+func TestBotPollUpdatesCancel(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			writeTestResponse(w, 200, `{"ok":true,"result":[{"update_id":7}]}`)
+			return
+		}
+		// long polling, respond only when client gives up
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	output := make(chan Update)
+	go func() {
+		<-output
+		cancel()
+	}()
+
+	started := time.Now()
+	offset, _, err := bot.PollUpdates(ctx, Params{"timeout": 15}, output)
+	if err != context.Canceled {
+		t.Fatalf("bot.PollUpdates() should've returned context.Canceled, got %v", err)
+	}
+
+	if offset != 8 {
+		t.Fatalf("Unexpected offset: %v", offset)
+	}
+
+	if time.Since(started) > 5*time.Second {
+		t.Fatal("bot.PollUpdates() didn't return promptly after cancel")
+	}
+
+	if _, ok := <-output; ok {
+		t.Fatal("output should be closed")
+	}
+}
+
+func TestBotPollUpdatesHandler(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			writeTestResponse(w, 200, `{"ok":true,"result":[{"update_id":1},{"update_id":2}]}`)
+			return
+		}
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var handled []Integer
+	offset, _, _ := bot.PollUpdatesHandler(ctx, Params{}, HandlerFunc(func(ctx context.Context, update *Update) error {
+		handled = append(handled, update.UpdateID)
+		if update.UpdateID == 2 {
+			cancel()
+		}
+		return nil
+	}))
+
+	if offset != 3 || len(handled) != 2 {
+		t.Fatalf("Unexpected offset %v, handled updates %v", offset, handled)
+	}
+}
@@ -1,12 +1,14 @@
 package tgbot
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"mime/multipart"
 	"net/http"
 )
 
@@ -17,6 +19,7 @@ import (
 // 	multipart/form-data (use to upload files)
 
 type request struct {
+	logger       *log.Logger
 	method       string
 	url          string
 	status       string
@@ -24,15 +27,19 @@ type request struct {
 	finishedWith string
 }
 
-func newRequest(method string, url string) *request {
+func newRequest(logger *log.Logger, method string, url string) *request {
 	st := &request{}
+	st.logger = logger
 	st.method = method
 	st.url = url
 	return st
 }
 
 func (r request) log() {
-	log.Printf("Request finished\n\t%v %v\n\tStatus: %v\n\tResponse: %v\n\tFinishedWith: %v\n",
+	if r.logger == nil {
+		return
+	}
+	r.logger.Printf("Request finished\n\t%v %v\n\tStatus: %v\n\tResponse: %v\n\tFinishedWith: %v\n",
 		r.method, r.url, r.status, r.response, r.finishedWith)
 }
 
@@ -41,8 +48,9 @@ func (r *request) process(httpResponse *http.Response, err error) (*Response, in
 
 	if err != nil {
 		r.finishedWith = err.Error()
-		return nil, httpResponse.StatusCode, errors.New("tgbot " + r.method + " request failed: " + err.Error())
+		return nil, 0, fmt.Errorf("tgbot %v request failed: %w", r.method, err)
 	}
+	defer httpResponse.Body.Close()
 	r.status = httpResponse.Status
 
 	// deserialize http response
@@ -54,58 +62,177 @@ func (r *request) process(httpResponse *http.Response, err error) (*Response, in
 		pending, _ := ioutil.ReadAll(httpResponse.Body)
 		r.response = string(buffered) + string(pending)
 		r.finishedWith = fmt.Sprintf("Failed to unmarshal TelegramBotAPI response: %v\n", err)
-		return nil, httpResponse.StatusCode, errors.New("tgbot http " + r.method + " request: " + err.Error())
+		return nil, httpResponse.StatusCode, fmt.Errorf("tgbot http %v request: %w", r.method, err)
 	}
 
+	response.statusCode = httpResponse.StatusCode
+
 	remarshaledResponse, _ := json.Marshal(response)
 	r.response = string(remarshaledResponse)
 	r.finishedWith = "Success"
 	return response, httpResponse.StatusCode, nil
 }
 
+// do send httpRequest, retry it according to bot.RetryPolicy
+func (bot *Bot) do(ctx context.Context, methodName string, httpRequest *http.Request) (*Response, int, error) {
+	clock := bot.RetryPolicy.getClock()
+	started := clock.Now()
+	for attempt := 1; ; attempt++ {
+		r := newRequest(bot.Logger, httpRequest.Method, httpRequest.URL.String())
+		response, status, err := r.process(bot.httpClient().Do(httpRequest))
+
+		delay, retry := bot.RetryPolicy.delay(ctx, methodName, attempt, clock.Now().Sub(started), response, status, err)
+		if !retry {
+			return response, status, err
+		}
+
+		bot.logf("Retrying %v in %v, attempt %v failed with status %v", methodName, delay, attempt, status)
+		if sleepErr := clock.Sleep(ctx, delay); sleepErr != nil {
+			return response, status, err
+		}
+
+		// rewind after sleep: multipart body is streamed by a goroutine holding open files until it is read or closed
+		nextRequest, rewindErr := rewindRequest(ctx, httpRequest)
+		if rewindErr != nil {
+			return response, status, err
+		}
+		httpRequest = nextRequest
+	}
+}
+
+// rewindRequest clone httpRequest with fresh body for another attempt
+func rewindRequest(ctx context.Context, httpRequest *http.Request) (*http.Request, error) {
+	next := httpRequest.Clone(ctx)
+	if httpRequest.Body == nil || httpRequest.Body == http.NoBody {
+		return next, nil
+	}
+
+	if httpRequest.GetBody == nil {
+		return nil, errors.New("tgbot.rewindRequest: request body can't be read again")
+	}
+	body, err := httpRequest.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("tgbot.rewindRequest: %w", err)
+	}
+	next.Body = body
+	return next, nil
+}
+
 // Get GET
-func Get(botAPIURL string, methodName string, params Params) (*Response, int, error) {
-	url := botAPIURL + methodName
+func (bot *Bot) Get(ctx context.Context, methodName string, params Params) (*Response, int, error) {
+	url := bot.APIURL + methodName
+	params = bot.withDefaults(params)
+	if err := bot.wait(ctx, methodName, params); err != nil {
+		return nil, 0, fmt.Errorf("tgbot.Bot.Get: %w", err)
+	}
 	if len(params) > 0 {
 		urlValues, err := params.URLValues()
 		if err != nil {
-			return nil, 0, errors.New("tgbot.Get: " + err.Error())
+			return nil, 0, fmt.Errorf("tgbot.Bot.Get: %w", err)
 		}
 		url = url + "?" + urlValues.Encode()
 	}
 
-	return newRequest("GET", url).process(http.Get(url))
+	httpRequest, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("tgbot.Bot.Get: %w", err)
+	}
+	return bot.do(ctx, methodName, httpRequest)
 }
 
 // Post POST
-func Post(botAPIURL string, methodName string, contentType string, contentReader io.Reader) (*Response, int, error) {
-	url := botAPIURL + methodName
-	return newRequest("POST", url).process(http.Post(url, contentType, contentReader))
+func (bot *Bot) Post(ctx context.Context, methodName string, contentType string, contentReader io.Reader) (*Response, int, error) {
+	return bot.post(ctx, methodName, contentType, contentReader, nil)
+}
+
+// post POST, getBody makes request body for retries, if not set by http.NewRequest
+func (bot *Bot) post(ctx context.Context, methodName string, contentType string, contentReader io.Reader,
+	getBody func() (io.ReadCloser, error)) (*Response, int, error) {
+	url := bot.APIURL + methodName
+	httpRequest, err := http.NewRequestWithContext(ctx, "POST", url, contentReader)
+	if err != nil {
+		if closer, ok := contentReader.(io.Closer); ok {
+			closer.Close()
+		}
+		return nil, 0, fmt.Errorf("tgbot.Bot.Post: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", contentType)
+	if getBody != nil {
+		httpRequest.GetBody = getBody
+	}
+	return bot.do(ctx, methodName, httpRequest)
 }
 
 // PostURLEncoded POST application/x-www-form-urlencoded
-func PostURLEncoded(botAPIURL string, methodName string, params Params) (*Response, int, error) {
+func (bot *Bot) PostURLEncoded(ctx context.Context, methodName string, params Params) (*Response, int, error) {
+	params = bot.withDefaults(params)
+	if err := bot.wait(ctx, methodName, params); err != nil {
+		return nil, 0, fmt.Errorf("tgbot.Bot.PostURLEncoded: %w", err)
+	}
 	contentReader, err := params.URLEncode()
 	if err != nil {
-		return nil, 0, errors.New("tgbot.PostURLEncoded: " + err.Error())
+		return nil, 0, fmt.Errorf("tgbot.Bot.PostURLEncoded: %w", err)
 	}
-	return Post(botAPIURL, methodName, "application/x-www-form-urlencoded", contentReader)
+	return bot.Post(ctx, methodName, "application/x-www-form-urlencoded", contentReader)
 }
 
 // PostJSON POST application/json
-func PostJSON(botAPIURL string, methodName string, params Params) (*Response, int, error) {
+func (bot *Bot) PostJSON(ctx context.Context, methodName string, params Params) (*Response, int, error) {
+	params = bot.withDefaults(params)
+	if err := bot.wait(ctx, methodName, params); err != nil {
+		return nil, 0, fmt.Errorf("tgbot.Bot.PostJSON: %w", err)
+	}
 	contentReader, err := params.JSONEncode()
 	if err != nil {
-		return nil, 0, errors.New("tgbot.PostJSON: " + err.Error())
+		return nil, 0, fmt.Errorf("tgbot.Bot.PostJSON: %w", err)
 	}
-	return Post(botAPIURL, methodName, "application/json", contentReader)
+	return bot.Post(ctx, methodName, "application/json", contentReader)
 }
 
 // PostMultipartForm POST multipart/form
-func PostMultipartForm(botAPIURL string, methodName string, params Params) (*Response, int, error) {
-	contentReader, err := params.MultipartFormEncode()
+func (bot *Bot) PostMultipartForm(ctx context.Context, methodName string, params Params) (*Response, int, error) {
+	params = bot.withDefaults(params)
+	if err := bot.wait(ctx, methodName, params); err != nil {
+		return nil, 0, fmt.Errorf("tgbot.Bot.PostMultipartForm: %w", err)
+	}
+	boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+	contentReader, err := params.multipartForm(boundary)
 	if err != nil {
-		return nil, 0, errors.New("tgbot.PostMultipartForm: " + err.Error())
+		return nil, 0, fmt.Errorf("tgbot.Bot.PostMultipartForm: %w", err)
 	}
-	return Post(botAPIURL, methodName, "multipart/form-data", contentReader)
+	getBody := func() (io.ReadCloser, error) { return params.multipartForm(boundary) }
+	return bot.post(ctx, methodName, "multipart/form-data; boundary="+boundary, contentReader, getBody)
+}
+
+// Call POST method with params as multipart/form-data if there are files to upload, otherwise as application/x-www-form-urlencoded
+func (bot *Bot) Call(ctx context.Context, methodName string, params Params) (*Response, int, error) {
+	if params.HasUploads() {
+		return bot.PostMultipartForm(ctx, methodName, params)
+	}
+	return bot.PostURLEncoded(ctx, methodName, params)
+}
+
+// Get GET
+func Get(botAPIURL string, methodName string, params Params) (*Response, int, error) {
+	return wrapBot(botAPIURL).Get(context.Background(), methodName, params)
+}
+
+// Post POST
+func Post(botAPIURL string, methodName string, contentType string, contentReader io.Reader) (*Response, int, error) {
+	return wrapBot(botAPIURL).Post(context.Background(), methodName, contentType, contentReader)
+}
+
+// PostURLEncoded POST application/x-www-form-urlencoded
+func PostURLEncoded(botAPIURL string, methodName string, params Params) (*Response, int, error) {
+	return wrapBot(botAPIURL).PostURLEncoded(context.Background(), methodName, params)
+}
+
+// PostJSON POST application/json
+func PostJSON(botAPIURL string, methodName string, params Params) (*Response, int, error) {
+	return wrapBot(botAPIURL).PostJSON(context.Background(), methodName, params)
+}
+
+// PostMultipartForm POST multipart/form
+func PostMultipartForm(botAPIURL string, methodName string, params Params) (*Response, int, error) {
+	return wrapBot(botAPIURL).PostMultipartForm(context.Background(), methodName, params)
 }
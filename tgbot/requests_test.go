@@ -1,6 +1,9 @@
 package tgbot
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
 	"testing"
 )
 
@@ -119,4 +122,62 @@ func TestPostJSONParamsOk(t *testing.T) {
 	}
 }
 
-// TODO: add TestMultipartFormParamsOk when Params.MultipartFormEncode implemented
+func TestMultipartFormParamsOk(t *testing.T) {
+	APIURL, err := LoadBotAPIURL(tokenFname)
+	if err != nil {
+		t.Fatal("Can't load API URL: " + err.Error())
+		return
+	}
+
+	response, status, err := PostMultipartForm(APIURL, "getMe", Params{"limit": 5})
+	if err != nil {
+		t.Fatal("POST request to telegramBotAPI failed: " + err.Error())
+		return
+	}
+
+	if status != 200 {
+		t.Fatalf("POST getMe httpStatus is %v (not 200 OK)\n", status)
+		return
+	}
+
+	if !response.Ok {
+		t.Fatal("POST getMe response.Ok == false")
+		return
+	}
+}
+
+func TestBotPostMultipartFormUpload(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Can't parse multipart form: %v", err)
+		}
+		file, header, err := r.FormFile("photo")
+		if err != nil {
+			t.Errorf("No photo uploaded: %v", err)
+			return
+		}
+		content, _ := ioutil.ReadAll(file)
+		if header.Filename != "cat.jpg" || string(content) != "jpeg content" || r.FormValue("chat_id") != "42" {
+			t.Errorf("Unexpected upload %v: %s, chat_id %v", header.Filename, content, r.FormValue("chat_id"))
+		}
+
+		if calls == 1 {
+			writeTestResponse(w, 502, `{"ok":false,"error_code":502,"description":"Bad Gateway"}`)
+			return
+		}
+		writeTestResponse(w, 200, testMessageResponse)
+	})
+	bot.RetryPolicy = &RetryPolicy{MaxAttempts: 2, RetryNonIdempotent: true, clock: newFakeClock()}
+
+	params := Params{"chat_id": 42, "photo": FileBytes("cat.jpg", []byte("jpeg content"))}
+	response, status, err := bot.PostMultipartForm(context.Background(), "sendPhoto", params)
+	if err != nil || status != 200 || !response.Ok {
+		t.Fatalf("bot.PostMultipartForm() failed: %v %v", status, err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Upload should be retried, got %v calls", calls)
+	}
+}
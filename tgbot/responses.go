@@ -3,6 +3,7 @@ package tgbot
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 )
 
 // ResponseParameters https://core.telegram.org/bots/api/#responseparameters
@@ -16,15 +17,38 @@ type Response struct {
 	Ok bool `json:"ok"`
 
 	// optional
+	ErrorCode   *Integer            `json:"error_code,omitempty"`
 	Description *string             `json:"description,omitempty"`
 	Parameters  *ResponseParameters `json:"parameters,omitempty"`
 	Result      *json.RawMessage    `json:"result,omitempty"`
+
+	statusCode int // HTTP status code, set when response is received
+}
+
+// Error builds *APIError from Ok==false response, returns nil if Ok is true
+func (response Response) Error() error {
+	if response.Ok {
+		return nil
+	}
+
+	apiErr := &APIError{
+		StatusCode: response.statusCode,
+		ErrorCode:  Integer(response.statusCode),
+		Parameters: response.Parameters,
+	}
+	if response.ErrorCode != nil {
+		apiErr.ErrorCode = *response.ErrorCode
+	}
+	if response.Description != nil {
+		apiErr.Description = *response.Description
+	}
+	return apiErr
 }
 
 // GetRawResult safely gets Result from Ok==true response
 func (response Response) GetRawResult() (*json.RawMessage, error) {
-	if response.Ok == false {
-		return nil, errors.New("tgbot.Response.GetRawResult: Ok is false")
+	if err := response.Error(); err != nil {
+		return nil, fmt.Errorf("tgbot.Response.GetRawResult: %w", err)
 	}
 
 	if response.Result == nil {
@@ -38,17 +62,39 @@ func (response Response) GetRawResult() (*json.RawMessage, error) {
 	return response.Result, nil
 }
 
+// GetResult safely unmarshals Result from Ok==true response into v
+func (response Response) GetResult(v interface{}) error {
+	result, err := response.GetRawResult()
+	if err != nil {
+		return fmt.Errorf("tgbot.Response.GetResult: %w", err)
+	}
+
+	err = json.Unmarshal(*result, v)
+	if err != nil {
+		return fmt.Errorf("tgbot.Response.GetResult unmarshal result as %T: %w", v, err)
+	}
+
+	return nil
+}
+
+// GetResultBool safely gets Result from Ok==true response as bool
+func (response Response) GetResultBool() (bool, error) {
+	var result bool
+	err := response.GetResult(&result)
+	return result, err
+}
+
 // GetResultUser safely gets Result from Ok==true response as User
 func (response Response) GetResultUser() (*User, error) {
 	result, err := response.GetRawResult()
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultUser: " + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultUser: %w", err)
 	}
 
 	var user User
 	err = json.Unmarshal(*result, &user)
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultUser unmarshal result as User:" + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultUser unmarshal result as User: %w", err)
 	}
 
 	return &user, nil
@@ -58,13 +104,13 @@ func (response Response) GetResultUser() (*User, error) {
 func (response Response) GetResultUpdates() ([]Update, error) {
 	result, err := response.GetRawResult()
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultUpdates: " + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultUpdates: %w", err)
 	}
 
 	var updates []Update
 	err = json.Unmarshal(*result, &updates)
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultUpdates unmarshal result as []Update:" + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultUpdates unmarshal result as []Update: %w", err)
 	}
 
 	return updates, nil
@@ -74,13 +120,13 @@ func (response Response) GetResultUpdates() ([]Update, error) {
 func (response Response) GetResultMessage() (*Message, error) {
 	result, err := response.GetRawResult()
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultMessage: " + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultMessage: %w", err)
 	}
 
 	var message Message
 	err = json.Unmarshal(*result, &message)
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultMessage unmarshal result as Message:" + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultMessage unmarshal result as Message: %w", err)
 	}
 
 	return &message, nil
@@ -90,14 +136,23 @@ func (response Response) GetResultMessage() (*Message, error) {
 func (response Response) GetResultFile() (*File, error) {
 	result, err := response.GetRawResult()
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultFile: " + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultFile: %w", err)
 	}
 
 	var file File
 	err = json.Unmarshal(*result, &file)
 	if err != nil {
-		return nil, errors.New("tgbot.Response.GetResultFile unmarshal result as Message:" + err.Error())
+		return nil, fmt.Errorf("tgbot.Response.GetResultFile unmarshal result as Message: %w", err)
 	}
 
 	return &file, nil
 }
+
+// GetResultWebhookInfo safely gets Result from Ok==true response as WebhookInfo
+func (response Response) GetResultWebhookInfo() (*WebhookInfo, error) {
+	var webhookInfo WebhookInfo
+	if err := response.GetResult(&webhookInfo); err != nil {
+		return nil, err
+	}
+	return &webhookInfo, nil
+}
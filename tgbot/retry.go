@@ -0,0 +1,119 @@
+package tgbot
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RetryPolicy retries failed requests:
+// flood control errors (429 Too Many Requests) after retry_after,
+// 5xx and network errors with exponential backoff and jitter.
+// Only idempotent methods (see IsIdempotentMethod) are retried unless RetryNonIdempotent is set.
+type RetryPolicy struct {
+	MaxAttempts int           // Max number of attempts including the first one
+	MaxElapsed  time.Duration // Total time budget for all attempts and waits between them, unlimited if 0
+	BaseDelay   time.Duration // Backoff delay after the first failed attempt, doubled after each next one
+	MaxDelay    time.Duration // Max backoff delay, unlimited if 0
+
+	// Optional
+	RetryNonIdempotent bool // Optional. Retry methods that may have side effects (send*, forward*, etc.), may cause duplicates on 5xx and network errors
+
+	clock clock // replaced in tests
+}
+
+// DefaultRetryPolicy 5 attempts within 1 minute, backoff from 0.5s to 30s
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		MaxElapsed:  time.Minute,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+var idempotentMethods = map[string]bool{
+	"kickChatMember":     true,
+	"unbanChatMember":    true,
+	"restrictChatMember": true,
+	"promoteChatMember":  true,
+	"pinChatMessage":     true,
+	"unpinChatMessage":   true,
+	"leaveChat":          true,
+}
+
+// IsIdempotentMethod reports whether repeating Bot API method has no additional effect:
+// get*, set*, delete*, edit* and chat member management methods
+func IsIdempotentMethod(methodName string) bool {
+	for _, prefix := range []string{"get", "set", "delete", "edit"} {
+		if strings.HasPrefix(methodName, prefix) {
+			return true
+		}
+	}
+	return idempotentMethods[methodName]
+}
+
+// delay returns time to wait before the next attempt, false if request should not be retried
+func (policy *RetryPolicy) delay(ctx context.Context, methodName string, attempt int, elapsed time.Duration,
+	response *Response, status int, err error) (time.Duration, bool) {
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	if !policy.RetryNonIdempotent && !IsIdempotentMethod(methodName) {
+		return 0, false
+	}
+
+	var delay time.Duration
+	switch {
+	case status == http.StatusTooManyRequests:
+		if apiErr, ok := AsAPIError(responseError(response)); ok && apiErr.RetryAfter() > 0 {
+			delay = apiErr.RetryAfter()
+		} else {
+			delay = policy.backoff(attempt)
+		}
+	case status >= 500:
+		delay = policy.backoff(attempt)
+	case status == 0 && err != nil:
+		// network error, no response received
+		delay = policy.backoff(attempt)
+	default:
+		return 0, false
+	}
+
+	if policy.MaxElapsed > 0 && elapsed+delay > policy.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// backoff exponential delay with jitter: random in [d/2, d), d = BaseDelay * 2^(attempt-1)
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < attempt && (policy.MaxDelay == 0 || delay < policy.MaxDelay); i++ {
+		delay *= 2
+	}
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if delay < 2 {
+		return delay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+func (policy *RetryPolicy) getClock() clock {
+	if policy == nil || policy.clock == nil {
+		return realClock{}
+	}
+	return policy.clock
+}
+
+func responseError(response *Response) error {
+	if response == nil {
+		return nil
+	}
+	return response.Error()
+}
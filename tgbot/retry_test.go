@@ -0,0 +1,206 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testMessageResponse string = `{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"}}}`
+
+func TestRetryFloodWait(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			writeTestResponse(w, 429, `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 3","parameters":{"retry_after":3}}`)
+			return
+		}
+		writeTestResponse(w, 200, testMessageResponse)
+	})
+	bot.RetryPolicy = DefaultRetryPolicy()
+	bot.RetryPolicy.RetryNonIdempotent = true
+	clock := newFakeClock()
+	bot.RetryPolicy.clock = clock
+
+	_, status, err := bot.SendMessage(context.Background(), Params{"chat_id": 1, "text": "hi"})
+	if err != nil {
+		t.Fatal("bot.SendMessage() failed: " + err.Error())
+	}
+
+	if status != 200 || calls != 2 {
+		t.Fatalf("Unexpected status %v after %v calls", status, calls)
+	}
+
+	if len(clock.Sleeps()) != 1 || clock.Sleeps()[0] != 3*time.Second {
+		t.Fatalf("Unexpected delays: %v", clock.Sleeps())
+	}
+}
+
+func TestRetryNonIdempotentNotRetried(t *testing.T) {
+	for _, status := range []int{500, 429} {
+		calls := 0
+		bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			writeTestResponse(w, status, fmt.Sprintf(`{"ok":false,"error_code":%v,"description":"Error","parameters":{"retry_after":1}}`, status))
+		})
+		bot.RetryPolicy = DefaultRetryPolicy()
+		bot.RetryPolicy.clock = newFakeClock()
+
+		_, received, err := bot.SendMessage(context.Background(), Params{"chat_id": 1, "text": "hi"})
+		if err == nil || received != status {
+			t.Fatalf("bot.SendMessage() should've failed with %v", status)
+		}
+
+		if calls != 1 {
+			t.Fatalf("sendMessage should not be retried on %v without RetryNonIdempotent, got %v calls", status, calls)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if body, _ := ioutil.ReadAll(r.Body); string(body) != `{"file_id":"abc"}` {
+			t.Errorf("Unexpected body on call %v: %s", calls, body)
+		}
+		if calls < 3 {
+			w.WriteHeader(502)
+			return
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"file_id":"abc"}}`)
+	})
+	bot.RetryPolicy = &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	clock := newFakeClock()
+	bot.RetryPolicy.clock = clock
+
+	response, status, err := bot.PostJSON(context.Background(), "getFile", Params{"file_id": "abc"})
+	if err != nil || status != 200 || !response.Ok {
+		t.Fatalf("bot.PostJSON() failed: %v %v", status, err)
+	}
+
+	if len(clock.Sleeps()) != 2 {
+		t.Fatalf("Unexpected delays: %v", clock.Sleeps())
+	}
+
+	first, second := clock.Sleeps()[0], clock.Sleeps()[1]
+	if first < 500*time.Millisecond || first >= time.Second || second < time.Second || second >= 2*time.Second {
+		t.Fatalf("Delays are out of backoff bounds: %v", clock.Sleeps())
+	}
+}
+
+func TestRetryLimits(t *testing.T) {
+	calls := 0
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		writeTestResponse(w, 429, `{"ok":false,"error_code":429,"description":"Too Many Requests","parameters":{"retry_after":20}}`)
+	})
+	bot.RetryPolicy = &RetryPolicy{MaxAttempts: 10, MaxElapsed: 30 * time.Second}
+	clock := newFakeClock()
+	bot.RetryPolicy.clock = clock
+
+	_, _, err := bot.GetMe(context.Background())
+	if !IsFloodWait(err) {
+		t.Fatalf("bot.GetMe() should've failed with flood wait, got %v", err)
+	}
+
+	// second wait would exceed MaxElapsed
+	if calls != 2 || len(clock.Sleeps()) != 1 {
+		t.Fatalf("Unexpected number of calls %v, delays %v", calls, clock.Sleeps())
+	}
+
+	calls = 0
+	bot.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+	bot.RetryPolicy.clock = newFakeClock()
+	bot.GetMe(context.Background())
+	if calls != 3 {
+		t.Fatalf("Unexpected number of calls %v with MaxAttempts 3", calls)
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		writeTestResponse(w, 503, `{"ok":false,"error_code":503,"description":"Service Unavailable"}`)
+	})
+	bot.RetryPolicy = &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	_, status, _ := bot.GetMe(ctx)
+	if status != 503 {
+		t.Fatalf("Unexpected status: %v", status)
+	}
+
+	if time.Since(started) > 5*time.Second {
+		t.Fatal("Retry didn't stop after context was cancelled")
+	}
+}
+
+// testUploadContent upload content counting open and closed readers
+type testUploadContent struct {
+	opened, closed int32
+}
+
+func (content *testUploadContent) open() (io.ReadCloser, error) {
+	atomic.AddInt32(&content.opened, 1)
+	return testUploadReader{strings.NewReader("png"), content}, nil
+}
+
+type testUploadReader struct {
+	io.Reader
+	content *testUploadContent
+}
+
+func (reader testUploadReader) Close() error {
+	atomic.AddInt32(&reader.content.closed, 1)
+	return nil
+}
+
+func TestRetryCancelClosesUploadBody(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		w.WriteHeader(502)
+	})
+	bot.RetryPolicy = &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}
+
+	content := &testUploadContent{}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	params := Params{"chat_id": 1, "photo": &InputFile{name: "photo.png", open: content.open}}
+	if _, status, _ := bot.PostMultipartForm(ctx, "setChatPhoto", params); status != 502 {
+		t.Fatalf("Unexpected status: %v", status)
+	}
+
+	// uploads are closed by the streaming goroutine, give it a moment
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&content.closed) != atomic.LoadInt32(&content.opened) && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if opened, closed := atomic.LoadInt32(&content.opened), atomic.LoadInt32(&content.closed); opened != 1 || closed != 1 {
+		t.Fatalf("Upload opened %v times, closed %v times", opened, closed)
+	}
+}
+
+func TestIsIdempotentMethod(t *testing.T) {
+	for _, methodName := range []string{"getUpdates", "setWebhook", "deleteMessage", "editMessageText", "kickChatMember"} {
+		if !IsIdempotentMethod(methodName) {
+			t.Fatal(methodName + " should be idempotent")
+		}
+	}
+
+	for _, methodName := range []string{"sendMessage", "forwardMessage", "exportChatInviteLink"} {
+		if IsIdempotentMethod(methodName) {
+			t.Fatal(methodName + " should not be idempotent")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SendStickerParams https://core.telegram.org/bots/api#sendsticker
+type SendStickerParams struct {
+	ChatID  interface{} `json:"chat_id"` // Unique identifier for the target chat (Integer) or username of the target channel in the format @channelusername (string)
+	Sticker *InputFile  `json:"sticker"` // Sticker to send (.webp): file_id, HTTP URL or upload
+
+	// Optional
+	SendOptions
+}
+
+// CreateNewStickerSetParams https://core.telegram.org/bots/api#createnewstickerset
+type CreateNewStickerSetParams struct {
+	UserID     Integer    `json:"user_id"`     // User identifier of created sticker set owner
+	Name       string     `json:"name"`        // Short name of sticker set, to be used in t.me/addstickers/ URLs. Must end in “_by_<bot username>”
+	Title      string     `json:"title"`       // Sticker set title, 1-64 characters
+	PNGSticker *InputFile `json:"png_sticker"` // Png image with the sticker, up to 512 kilobytes, one side must be exactly 512px: file_id, HTTP URL or upload
+	Emojis     string     `json:"emojis"`      // One or more emoji corresponding to the sticker
+
+	// Optional
+	ContainsMasks bool          `json:"contains_masks,omitempty"` // Optional. Pass True, if a set of mask stickers should be created
+	MaskPosition  *MaskPosition `json:"mask_position,omitempty"`  // Optional. Position where the mask should be placed on faces
+}
+
+// AddStickerToSetParams https://core.telegram.org/bots/api#addstickertoset
+type AddStickerToSetParams struct {
+	UserID     Integer    `json:"user_id"`     // User identifier of sticker set owner
+	Name       string     `json:"name"`        // Sticker set name
+	PNGSticker *InputFile `json:"png_sticker"` // Png image with the sticker, up to 512 kilobytes, one side must be exactly 512px: file_id, HTTP URL or upload
+	Emojis     string     `json:"emojis"`      // One or more emoji corresponding to the sticker
+
+	// Optional
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // Optional. Position where the mask should be placed on faces
+}
+
+// SendSticker https://core.telegram.org/bots/api#sendsticker
+func (bot *Bot) SendSticker(ctx context.Context, params SendStickerParams) (*Message, int, error) {
+	return bot.callMessage(ctx, "sendSticker", paramsOf(params))
+}
+
+// GetStickerSet https://core.telegram.org/bots/api#getstickerset
+func (bot *Bot) GetStickerSet(ctx context.Context, name string) (*StickerSet, int, error) {
+	response, status, err := bot.Get(ctx, "getStickerSet", Params{"name": name})
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.GetStickerSet: %w", err)
+	}
+
+	stickerSet := &StickerSet{}
+	if err = response.GetResult(stickerSet); err != nil {
+		return nil, status, fmt.Errorf("tgbot.GetStickerSet: %w", err)
+	}
+
+	return stickerSet, status, nil
+}
+
+// UploadStickerFile https://core.telegram.org/bots/api#uploadstickerfile
+// pngSticker must be an upload, returned File.FileID may be used in CreateNewStickerSet and AddStickerToSet
+func (bot *Bot) UploadStickerFile(ctx context.Context, userID Integer, pngSticker *InputFile) (*File, int, error) {
+	if pngSticker == nil || !pngSticker.IsUpload() {
+		return nil, 0, errors.New("tgbot.UploadStickerFile: png_sticker must be an upload")
+	}
+
+	response, status, err := bot.PostMultipartForm(ctx, "uploadStickerFile", Params{"user_id": userID, "png_sticker": pngSticker})
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.UploadStickerFile: %w", err)
+	}
+
+	file, err := response.GetResultFile()
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.UploadStickerFile: %w", err)
+	}
+
+	return file, status, nil
+}
+
+// CreateNewStickerSet https://core.telegram.org/bots/api#createnewstickerset
+func (bot *Bot) CreateNewStickerSet(ctx context.Context, params CreateNewStickerSetParams) (bool, int, error) {
+	return bot.callBool(ctx, "createNewStickerSet", paramsOf(params))
+}
+
+// AddStickerToSet https://core.telegram.org/bots/api#addstickertoset
+func (bot *Bot) AddStickerToSet(ctx context.Context, params AddStickerToSetParams) (bool, int, error) {
+	return bot.callBool(ctx, "addStickerToSet", paramsOf(params))
+}
+
+// SetStickerPositionInSet https://core.telegram.org/bots/api#setstickerpositioninset
+func (bot *Bot) SetStickerPositionInSet(ctx context.Context, sticker string, position Integer) (bool, int, error) {
+	return bot.callBool(ctx, "setStickerPositionInSet", Params{"sticker": sticker, "position": position})
+}
+
+// DeleteStickerFromSet https://core.telegram.org/bots/api#deletestickerfromset
+func (bot *Bot) DeleteStickerFromSet(ctx context.Context, sticker string) (bool, int, error) {
+	return bot.callBool(ctx, "deleteStickerFromSet", Params{"sticker": sticker})
+}
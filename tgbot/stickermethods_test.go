@@ -0,0 +1,107 @@
+package tgbot
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBotGetStickerSet(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/getStickerSet") || r.URL.Query().Get("name") != "cats_by_testbot" {
+			t.Errorf("Unexpected request: %v", r.URL)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"name":"cats_by_testbot","title":"Cats","contains_masks":true,"stickers":[
+			{"file_id":"CAAD","width":512,"height":512,"emoji":"😺","set_name":"cats_by_testbot","mask_position":{"point":"eyes","x_shift":-1.0,"y_shift":0.5,"scale":2.0}}]}}`)
+	})
+
+	stickerSet, _, err := bot.GetStickerSet(context.Background(), "cats_by_testbot")
+	if err != nil {
+		t.Fatal("bot.GetStickerSet() failed: " + err.Error())
+	}
+
+	if stickerSet.Title != "Cats" || !stickerSet.ContainsMasks || len(stickerSet.Stickers) != 1 {
+		t.Fatalf("Unexpected sticker set: %+v", stickerSet)
+	}
+	sticker := stickerSet.Stickers[0]
+	if sticker.FileID != "CAAD" || *sticker.Emoji != "😺" || sticker.MaskPosition == nil || sticker.MaskPosition.Point != "eyes" || sticker.MaskPosition.Scale != 2 {
+		t.Fatalf("Unexpected sticker: %+v", sticker)
+	}
+}
+
+func TestBotUploadStickerFile(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("png_sticker")
+		if err != nil {
+			t.Errorf("No sticker uploaded: %v", err)
+			return
+		}
+		content, _ := ioutil.ReadAll(file)
+		if header.Filename != "cat.png" || string(content) != "png" || r.FormValue("user_id") != "7" {
+			t.Errorf("Unexpected upload %v: %s, form %v", header.Filename, content, r.Form)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":{"file_id":"AgADpng","file_size":3}}`)
+	})
+
+	file, _, err := bot.UploadStickerFile(context.Background(), 7, FileBytes("cat.png", []byte("png")))
+	if err != nil {
+		t.Fatal("bot.UploadStickerFile() failed: " + err.Error())
+	}
+	if file.FileID != "AgADpng" {
+		t.Fatalf("Unexpected file: %+v", file)
+	}
+
+	if _, _, err = bot.UploadStickerFile(context.Background(), 7, FileID("AgADpng")); err == nil {
+		t.Fatal("bot.UploadStickerFile() must reject file_id")
+	}
+}
+
+func TestBotCreateNewStickerSet(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		expected := map[string]string{
+			"user_id":        "7",
+			"name":           "masks_by_testbot",
+			"title":          "Masks",
+			"png_sticker":    "AgADpng",
+			"emojis":         "😎",
+			"contains_masks": "true",
+			"mask_position":  `{"point":"eyes","x_shift":0,"y_shift":0,"scale":1}`,
+		}
+		for key, value := range expected {
+			if r.PostForm.Get(key) != value {
+				t.Errorf("Unexpected %v: %v", key, r.PostForm.Get(key))
+			}
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	ok, _, err := bot.CreateNewStickerSet(context.Background(), CreateNewStickerSetParams{
+		UserID:        7,
+		Name:          "masks_by_testbot",
+		Title:         "Masks",
+		PNGSticker:    FileID("AgADpng"),
+		Emojis:        "😎",
+		ContainsMasks: true,
+		MaskPosition:  &MaskPosition{Point: "eyes", Scale: 1},
+	})
+	if err != nil || !ok {
+		t.Fatal("bot.CreateNewStickerSet() failed: ", ok, err)
+	}
+}
+
+func TestBotSetStickerPositionInSet(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("sticker") != "CAAD" || r.PostForm.Get("position") != "0" {
+			t.Errorf("Unexpected form: %v", r.PostForm)
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	if ok, _, err := bot.SetStickerPositionInSet(context.Background(), "CAAD", 0); err != nil || !ok {
+		t.Fatal("bot.SetStickerPositionInSet() failed: ", ok, err)
+	}
+}
@@ -0,0 +1,214 @@
+package tgbot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ConversationState state of a conversation kept in Storage
+type ConversationState struct {
+	State     string            `json:"state"`          // Name of the current FSM state
+	Data      map[string]string `json:"data,omitempty"` // Values collected during conversation
+	UpdatedAt time.Time         `json:"updated_at"`     // Time of the last transition
+}
+
+// Storage persists conversation states by key, see FSM
+type Storage interface {
+	Get(ctx context.Context, key string) (*ConversationState, bool, error)
+	Set(ctx context.Context, key string, state *ConversationState) error
+	Delete(ctx context.Context, key string) error
+	// Expire delete states last updated before the time, returns deleted states by key
+	Expire(ctx context.Context, before time.Time) (map[string]*ConversationState, error)
+}
+
+// MemoryStorage Storage keeping states in memory, states are lost on restart
+type MemoryStorage struct {
+	mutex  sync.RWMutex
+	states map[string]ConversationState
+}
+
+// NewMemoryStorage create empty MemoryStorage
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{states: map[string]ConversationState{}}
+}
+
+// Get implements Storage
+func (storage *MemoryStorage) Get(ctx context.Context, key string) (*ConversationState, bool, error) {
+	storage.mutex.RLock()
+	defer storage.mutex.RUnlock()
+
+	state, ok := storage.states[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return state.clone(), true, nil
+}
+
+// Set implements Storage
+func (storage *MemoryStorage) Set(ctx context.Context, key string, state *ConversationState) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	storage.states[key] = *state.clone()
+	return nil
+}
+
+// Delete implements Storage
+func (storage *MemoryStorage) Delete(ctx context.Context, key string) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	delete(storage.states, key)
+	return nil
+}
+
+// Expire implements Storage
+func (storage *MemoryStorage) Expire(ctx context.Context, before time.Time) (map[string]*ConversationState, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+
+	expired := map[string]*ConversationState{}
+	for key, state := range storage.states {
+		if state.UpdatedAt.Before(before) {
+			expired[key] = state.clone()
+			delete(storage.states, key)
+		}
+	}
+	return expired, nil
+}
+
+// FileStorage Storage keeping states in memory and in a local JSON file, so they survive restarts.
+// The file is rewritten atomically on every change, failed changes are not applied in memory either.
+type FileStorage struct {
+	path   string
+	memory *MemoryStorage
+	mutex  sync.Mutex // serializes writes of the file
+}
+
+// OpenFileStorage open FileStorage at path, states are loaded from the file if it exists
+func OpenFileStorage(path string) (*FileStorage, error) {
+	storage := &FileStorage{path: path, memory: NewMemoryStorage()}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return storage, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("tgbot.OpenFileStorage: %w", err)
+	}
+
+	if err = json.Unmarshal(data, &storage.memory.states); err != nil {
+		return nil, fmt.Errorf("tgbot.OpenFileStorage: %v: %w", path, err)
+	}
+	return storage, nil
+}
+
+// Get implements Storage
+func (storage *FileStorage) Get(ctx context.Context, key string) (*ConversationState, bool, error) {
+	return storage.memory.Get(ctx, key)
+}
+
+// Set implements Storage
+func (storage *FileStorage) Set(ctx context.Context, key string, state *ConversationState) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+
+	previous, existed, _ := storage.memory.Get(ctx, key)
+	storage.memory.Set(ctx, key, state)
+	if err := storage.save(); err != nil {
+		storage.restore(ctx, key, previous, existed)
+		return err
+	}
+	return nil
+}
+
+// Delete implements Storage
+func (storage *FileStorage) Delete(ctx context.Context, key string) error {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+
+	previous, existed, _ := storage.memory.Get(ctx, key)
+	storage.memory.Delete(ctx, key)
+	if err := storage.save(); err != nil {
+		storage.restore(ctx, key, previous, existed)
+		return err
+	}
+	return nil
+}
+
+// Expire implements Storage
+func (storage *FileStorage) Expire(ctx context.Context, before time.Time) (map[string]*ConversationState, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+
+	expired, _ := storage.memory.Expire(ctx, before)
+	if len(expired) == 0 {
+		return expired, nil
+	}
+	if err := storage.save(); err != nil {
+		for key, state := range expired {
+			storage.memory.Set(ctx, key, state)
+		}
+		return nil, err
+	}
+	return expired, nil
+}
+
+// restore state of key in memory after failed save, so memory matches the file
+func (storage *FileStorage) restore(ctx context.Context, key string, previous *ConversationState, existed bool) {
+	if existed {
+		storage.memory.Set(ctx, key, previous)
+	} else {
+		storage.memory.Delete(ctx, key)
+	}
+}
+
+func (storage *FileStorage) save() error {
+	storage.memory.mutex.RLock()
+	data, err := json.Marshal(storage.memory.states)
+	storage.memory.mutex.RUnlock()
+	if err != nil {
+		return fmt.Errorf("tgbot.FileStorage: %w", err)
+	}
+
+	if err = writeFileAtomic(storage.path, data); err != nil {
+		return fmt.Errorf("tgbot.FileStorage: %w", err)
+	}
+	return nil
+}
+
+func (state *ConversationState) clone() *ConversationState {
+	cloned := *state
+	if state.Data != nil {
+		cloned.Data = make(map[string]string, len(state.Data))
+		for key, value := range state.Data {
+			cloned.Data[key] = value
+		}
+	}
+	return &cloned
+}
+
+// writeFileAtomic write data to temporary file next to path, sync and rename it to path
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
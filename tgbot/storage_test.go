@@ -0,0 +1,117 @@
+package tgbot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testStorage(t *testing.T, storage Storage) {
+	ctx := context.Background()
+
+	if _, ok, err := storage.Get(ctx, "1:1"); err != nil || ok {
+		t.Fatal("Get of missing key failed: ", ok, err)
+	}
+
+	state := &ConversationState{State: "name", Data: map[string]string{"a": "b"}, UpdatedAt: time.Unix(1500000000, 0).UTC()}
+	if err := storage.Set(ctx, "1:1", state); err != nil {
+		t.Fatal("Set failed: " + err.Error())
+	}
+	state.Data["a"] = "changed"
+
+	loaded, ok, err := storage.Get(ctx, "1:1")
+	if err != nil || !ok {
+		t.Fatal("Get failed: ", ok, err)
+	}
+	if loaded.State != "name" || loaded.Data["a"] != "b" || !loaded.UpdatedAt.Equal(state.UpdatedAt) {
+		t.Fatalf("Unexpected state %+v", loaded)
+	}
+
+	if err = storage.Delete(ctx, "1:1"); err != nil {
+		t.Fatal("Delete failed: " + err.Error())
+	}
+	if _, ok, _ = storage.Get(ctx, "1:1"); ok {
+		t.Fatal("State is not deleted")
+	}
+
+	storage.Set(ctx, "1:1", &ConversationState{State: "old", UpdatedAt: time.Unix(1500000000, 0)})
+	storage.Set(ctx, "1:2", &ConversationState{State: "new", UpdatedAt: time.Unix(1500000100, 0)})
+	expired, err := storage.Expire(ctx, time.Unix(1500000050, 0))
+	if err != nil {
+		t.Fatal("Expire failed: " + err.Error())
+	}
+	if len(expired) != 1 || expired["1:1"] == nil || expired["1:1"].State != "old" {
+		t.Fatalf("Unexpected expired states %v", expired)
+	}
+	if _, ok, _ = storage.Get(ctx, "1:1"); ok {
+		t.Fatal("Expired state is not deleted")
+	}
+	if _, ok, _ = storage.Get(ctx, "1:2"); !ok {
+		t.Fatal("Fresh state should not expire")
+	}
+}
+
+func TestMemoryStorage(t *testing.T) {
+	testStorage(t, NewMemoryStorage())
+}
+
+func TestFileStorage(t *testing.T) {
+	storage, err := OpenFileStorage(filepath.Join(t.TempDir(), "states.json"))
+	if err != nil {
+		t.Fatal("OpenFileStorage failed: " + err.Error())
+	}
+	testStorage(t, storage)
+}
+
+func TestFileStorageSurvivesReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "states.json")
+	storage, err := OpenFileStorage(path)
+	if err != nil {
+		t.Fatal("OpenFileStorage failed: " + err.Error())
+	}
+	if err = storage.Set(context.Background(), "1:2", &ConversationState{State: "age"}); err != nil {
+		t.Fatal("Set failed: " + err.Error())
+	}
+
+	reopened, err := OpenFileStorage(path)
+	if err != nil {
+		t.Fatal("OpenFileStorage failed: " + err.Error())
+	}
+	state, ok, err := reopened.Get(context.Background(), "1:2")
+	if err != nil || !ok || state.State != "age" {
+		t.Fatal("State is not restored: ", state, ok, err)
+	}
+}
+
+func TestFileStorageFailedSave(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "states")
+	os.Mkdir(dir, 0755)
+	storage, err := OpenFileStorage(filepath.Join(dir, "states.json"))
+	if err != nil {
+		t.Fatal("OpenFileStorage failed: " + err.Error())
+	}
+	ctx := context.Background()
+	if err = storage.Set(ctx, "1:1", &ConversationState{State: "name"}); err != nil {
+		t.Fatal("Set failed: " + err.Error())
+	}
+
+	os.RemoveAll(dir)
+	if err = storage.Set(ctx, "1:1", &ConversationState{State: "age"}); err == nil {
+		t.Fatal("Set should fail when the file can't be written")
+	}
+	if err = storage.Delete(ctx, "1:1"); err == nil {
+		t.Fatal("Delete should fail when the file can't be written")
+	}
+	if err = storage.Set(ctx, "1:2", &ConversationState{State: "age"}); err == nil {
+		t.Fatal("Set should fail when the file can't be written")
+	}
+
+	if state, ok, _ := storage.Get(ctx, "1:1"); !ok || state.State != "name" {
+		t.Fatal("Failed changes should not be applied: ", state, ok)
+	}
+	if _, ok, _ := storage.Get(ctx, "1:2"); ok {
+		t.Fatal("Failed Set should not add state")
+	}
+}
@@ -44,14 +44,15 @@ type Chat struct {
 	AllMembersAreAdministrators *bool   `json:"all_members_are_administrators,omitempty"` // Optional. True if a group has ‘All Members Are Admins’ enabled
 
 	// Optional, returned only in getChat
-	Photo       *ChatPhoto `json:"photo,omitempty"`       // Optional. Chat photo. Returned only in getChat
-	Description *string    `json:"description,omitempty"` // Optional. Description, for supergroups and channel chats. Returned only in getChat
-	InviteLink  *string    `json:"invite_link,omitempty"` // Optional. Chat invite link, for supergroups and channel chats. Returned only in getChat
+	Photo         *ChatPhoto `json:"photo,omitempty"`          // Optional. Chat photo. Returned only in getChat
+	Description   *string    `json:"description,omitempty"`    // Optional. Description, for supergroups and channel chats. Returned only in getChat
+	InviteLink    *string    `json:"invite_link,omitempty"`    // Optional. Chat invite link, for supergroups and channel chats. Returned only in getChat
+	PinnedMessage *Message   `json:"pinned_message,omitempty"` // Optional. Pinned message, for supergroups and channel chats. Returned only in getChat
 }
 
 // Message https://core.telegram.org/bots/api#message
 type Message struct {
-	ID Integer `json:"id"` // Unique message identifier inside this chat
+	ID Integer `json:"message_id"` // Unique message identifier inside this chat
 
 	// Optional
 	From *User `json:"from,omitempty"` // Optional. Sender, can be empty for messages sent to channels
@@ -350,61 +351,479 @@ type Update struct {
 	PreCheckoutQuery   *PreCheckoutQuery   `json:"pre_checkout_query,omitempty"`   // Optional. New incoming pre-checkout query. Contains full information about checkout
 }
 
+// WebhookInfo https://core.telegram.org/bots/api#webhookinfo
+type WebhookInfo struct {
+	URL                  string  `json:"url"`                    // Webhook URL, may be empty if webhook is not set up
+	HasCustomCertificate bool    `json:"has_custom_certificate"` // True, if a custom certificate was provided for webhook certificate checks
+	PendingUpdateCount   Integer `json:"pending_update_count"`   // Number of updates awaiting delivery
+
+	// Optional
+	LastErrorDate    *Integer `json:"last_error_date,omitempty"`    // Optional. Unix time for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorMessage *string  `json:"last_error_message,omitempty"` // Optional. Error message in human-readable format for the most recent error that happened when trying to deliver an update via webhook
+	MaxConnections   *Integer `json:"max_connections,omitempty"`    // Optional. Maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery
+	AllowedUpdates   []string `json:"allowed_updates,omitempty"`    // Optional. A list of update types the bot is subscribed to. Defaults to all update types
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // Sticker Types
 ///////////////////////////////////////////////////////////////////////////////
 
 // Sticker https://core.telegram.org/bots/api/#sticker
-type Sticker Dummy
+type Sticker struct {
+	FileID string  `json:"file_id"` // Unique identifier for this file
+	Width  Integer `json:"width"`   // Sticker width
+	Height Integer `json:"height"`  // Sticker height
+
+	// Optional
+	Thumb        *PhotoSize    `json:"thumb,omitempty"`         // Optional. Sticker thumbnail in the .webp or .jpg format
+	Emoji        *string       `json:"emoji,omitempty"`         // Optional. Emoji associated with the sticker
+	SetName      *string       `json:"set_name,omitempty"`      // Optional. Name of the sticker set to which the sticker belongs
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"` // Optional. For mask stickers, the position where the mask should be placed
+	FileSize     *Integer      `json:"file_size,omitempty"`     // Optional. File size
+}
 
 // StickerSet https://core.telegram.org/bots/api#stickerset
-type StickerSet Dummy
+type StickerSet struct {
+	Name          string    `json:"name"`           // Sticker set name
+	Title         string    `json:"title"`          // Sticker set title
+	ContainsMasks bool      `json:"contains_masks"` // True, if the sticker set contains masks
+	Stickers      []Sticker `json:"stickers"`       // List of all set stickers
+}
 
 // MaskPosition https://core.telegram.org/bots/api#maskposition
-type MaskPosition Dummy
+type MaskPosition struct {
+	Point  string  `json:"point"`   // The part of the face relative to which the mask should be placed. One of “forehead”, “eyes”, “mouth”, or “chin”.
+	XShift float64 `json:"x_shift"` // Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. For example, choosing -1.0 will place mask just to the left of the default mask position.
+	YShift float64 `json:"y_shift"` // Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. For example, 1.0 will place the mask just below the default mask position.
+	Scale  float64 `json:"scale"`   // Mask scaling coefficient. For example, 2.0 means double size.
+}
 
 ///////////////////////////////////////////////////////////////////////////////
 // InlineMode Types
 ///////////////////////////////////////////////////////////////////////////////
 
 // InlineQuery https://core.telegram.org/bots/api/#inlinequery
-type InlineQuery Dummy
+type InlineQuery struct {
+	ID   string `json:"id"`   // Unique identifier for this query
+	From User   `json:"from"` // Sender
+
+	// Optional
+	Location *Location `json:"location,omitempty"` // Optional. Sender location, only for bots that request user location
+
+	Query  string `json:"query"`  // Text of the query (up to 512 characters)
+	Offset string `json:"offset"` // Offset of the results to be returned, can be controlled by the bot
+}
 
 // InlineQueryResult https://core.telegram.org/bots/api/#inlinequeryresult
-type InlineQueryResult Dummy
+// Implemented by InlineQueryResult* types, ResultType is marshaled as "type" field
+type InlineQueryResult interface {
+	ResultType() string
+}
+
+// InlineQueryResultArticle https://core.telegram.org/bots/api/#inlinequeryresultarticle
+type InlineQueryResultArticle struct {
+	ID                  string      `json:"id"`                    // Unique identifier for this result, 1-64 Bytes
+	Title               string      `json:"title"`                 // Title of the result
+	InputMessageContent interface{} `json:"input_message_content"` // Content of the message to be sent: InputTextMessageContent, InputLocationMessageContent, InputVenueMessageContent or InputContactMessageContent
+
+	// Optional
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Optional. Inline keyboard attached to the message
+	URL         string                `json:"url,omitempty"`          // Optional. URL of the result
+	HideURL     bool                  `json:"hide_url,omitempty"`     // Optional. Pass True, if you don't want the URL to be shown in the message
+	Description string                `json:"description,omitempty"`  // Optional. Short description of the result
+	ThumbURL    string                `json:"thumb_url,omitempty"`    // Optional. Url of the thumbnail for the result
+	ThumbWidth  Integer               `json:"thumb_width,omitempty"`  // Optional. Thumbnail width
+	ThumbHeight Integer               `json:"thumb_height,omitempty"` // Optional. Thumbnail height
+}
+
+// InlineQueryResultPhoto https://core.telegram.org/bots/api/#inlinequeryresultphoto
+type InlineQueryResultPhoto struct {
+	ID       string `json:"id"`        // Unique identifier for this result, 1-64 bytes
+	PhotoURL string `json:"photo_url"` // A valid URL of the photo. Photo must be in jpeg format. Photo size must not exceed 5MB
+	ThumbURL string `json:"thumb_url"` // URL of the thumbnail for the photo
+
+	// Optional
+	PhotoWidth          Integer               `json:"photo_width,omitempty"`           // Optional. Width of the photo
+	PhotoHeight         Integer               `json:"photo_height,omitempty"`          // Optional. Height of the photo
+	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
+	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the photo to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the photo
+}
+
+// InlineQueryResultGif https://core.telegram.org/bots/api/#inlinequeryresultgif
+type InlineQueryResultGif struct {
+	ID       string `json:"id"`        // Unique identifier for this result, 1-64 bytes
+	GifURL   string `json:"gif_url"`   // A valid URL for the GIF file. File size must not exceed 1MB
+	ThumbURL string `json:"thumb_url"` // URL of the static thumbnail for the result (jpeg or gif)
+
+	// Optional
+	GifWidth            Integer               `json:"gif_width,omitempty"`             // Optional. Width of the GIF
+	GifHeight           Integer               `json:"gif_height,omitempty"`            // Optional. Height of the GIF
+	GifDuration         Integer               `json:"gif_duration,omitempty"`          // Optional. Duration of the GIF
+	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the GIF file to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the GIF animation
+}
+
+// InlineQueryResultMpeg4Gif https://core.telegram.org/bots/api/#inlinequeryresultmpeg4gif
+type InlineQueryResultMpeg4Gif struct {
+	ID       string `json:"id"`        // Unique identifier for this result, 1-64 bytes
+	Mpeg4URL string `json:"mpeg4_url"` // A valid URL for the MP4 file. File size must not exceed 1MB
+	ThumbURL string `json:"thumb_url"` // URL of the static thumbnail (jpeg or gif) for the result
+
+	// Optional
+	Mpeg4Width          Integer               `json:"mpeg4_width,omitempty"`           // Optional. Video width
+	Mpeg4Height         Integer               `json:"mpeg4_height,omitempty"`          // Optional. Video height
+	Mpeg4Duration       Integer               `json:"mpeg4_duration,omitempty"`        // Optional. Video duration
+	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the MPEG-4 file to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video animation
+}
+
+// InlineQueryResultVideo https://core.telegram.org/bots/api/#inlinequeryresultvideo
+type InlineQueryResultVideo struct {
+	ID       string `json:"id"`        // Unique identifier for this result, 1-64 bytes
+	VideoURL string `json:"video_url"` // A valid URL for the embedded video player or video file
+	MIMEType string `json:"mime_type"` // Mime type of the content of video url, “text/html” or “video/mp4”
+	ThumbURL string `json:"thumb_url"` // URL of the thumbnail (jpeg only) for the video
+	Title    string `json:"title"`     // Title for the result
+
+	// Optional
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the video to be sent, 0-200 characters
+	VideoWidth          Integer               `json:"video_width,omitempty"`           // Optional. Video width
+	VideoHeight         Integer               `json:"video_height,omitempty"`          // Optional. Video height
+	VideoDuration       Integer               `json:"video_duration,omitempty"`        // Optional. Video duration in seconds
+	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video. Required if the result is an HTML-page with embedded video player
+}
+
+// InlineQueryResultAudio https://core.telegram.org/bots/api/#inlinequeryresultaudio
+type InlineQueryResultAudio struct {
+	ID       string `json:"id"`        // Unique identifier for this result, 1-64 bytes
+	AudioURL string `json:"audio_url"` // A valid URL for the audio file
+	Title    string `json:"title"`     // Title
+
+	// Optional
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-200 characters
+	Performer           string                `json:"performer,omitempty"`             // Optional. Performer
+	AudioDuration       Integer               `json:"audio_duration,omitempty"`        // Optional. Audio duration in seconds
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the audio
+}
+
+// InlineQueryResultVoice https://core.telegram.org/bots/api/#inlinequeryresultvoice
+type InlineQueryResultVoice struct {
+	ID       string `json:"id"`        // Unique identifier for this result, 1-64 bytes
+	VoiceURL string `json:"voice_url"` // A valid URL for the voice recording
+	Title    string `json:"title"`     // Recording title
+
+	// Optional
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-200 characters
+	VoiceDuration       Integer               `json:"voice_duration,omitempty"`        // Optional. Recording duration in seconds
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the voice recording
+}
+
+// InlineQueryResultDocument https://core.telegram.org/bots/api/#inlinequeryresultdocument
+type InlineQueryResultDocument struct {
+	ID          string `json:"id"`           // Unique identifier for this result, 1-64 bytes
+	Title       string `json:"title"`        // Title for the result
+	DocumentURL string `json:"document_url"` // A valid URL for the file
+	MIMEType    string `json:"mime_type"`    // Mime type of the content of the file, either “application/pdf” or “application/zip”
+
+	// Optional
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the document to be sent, 0-200 characters
+	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the file
+	ThumbURL            string                `json:"thumb_url,omitempty"`             // Optional. URL of the thumbnail (jpeg only) for the file
+	ThumbWidth          Integer               `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
+	ThumbHeight         Integer               `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+}
+
+// InlineQueryResultLocation https://core.telegram.org/bots/api/#inlinequeryresultlocation
+type InlineQueryResultLocation struct {
+	ID        string  `json:"id"`        // Unique identifier for this result, 1-64 Bytes
+	Latitude  float64 `json:"latitude"`  // Location latitude in degrees
+	Longitude float64 `json:"longitude"` // Location longitude in degrees
+	Title     string  `json:"title"`     // Location title
+
+	// Optional
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the location
+	ThumbURL            string                `json:"thumb_url,omitempty"`             // Optional. Url of the thumbnail for the result
+	ThumbWidth          Integer               `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
+	ThumbHeight         Integer               `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+}
+
+// InlineQueryResultVenue https://core.telegram.org/bots/api/#inlinequeryresultvenue
+type InlineQueryResultVenue struct {
+	ID        string  `json:"id"`        // Unique identifier for this result, 1-64 Bytes
+	Latitude  float64 `json:"latitude"`  // Latitude of the venue location in degrees
+	Longitude float64 `json:"longitude"` // Longitude of the venue location in degrees
+	Title     string  `json:"title"`     // Title of the venue
+	Address   string  `json:"address"`   // Address of the venue
+
+	// Optional
+	FoursquareID        string                `json:"foursquare_id,omitempty"`         // Optional. Foursquare identifier of the venue if known
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the venue
+	ThumbURL            string                `json:"thumb_url,omitempty"`             // Optional. Url of the thumbnail for the result
+	ThumbWidth          Integer               `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
+	ThumbHeight         Integer               `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+}
+
+// InlineQueryResultContact https://core.telegram.org/bots/api/#inlinequeryresultcontact
+type InlineQueryResultContact struct {
+	ID          string `json:"id"`           // Unique identifier for this result, 1-64 Bytes
+	PhoneNumber string `json:"phone_number"` // Contact's phone number
+	FirstName   string `json:"first_name"`   // Contact's first name
+
+	// Optional
+	LastName            string                `json:"last_name,omitempty"`             // Optional. Contact's last name
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the contact
+	ThumbURL            string                `json:"thumb_url,omitempty"`             // Optional. Url of the thumbnail for the result
+	ThumbWidth          Integer               `json:"thumb_width,omitempty"`           // Optional. Thumbnail width
+	ThumbHeight         Integer               `json:"thumb_height,omitempty"`          // Optional. Thumbnail height
+}
+
+// InlineQueryResultGame https://core.telegram.org/bots/api/#inlinequeryresultgame
+type InlineQueryResultGame struct {
+	ID            string `json:"id"`              // Unique identifier for this result, 1-64 bytes
+	GameShortName string `json:"game_short_name"` // Short name of the game
+
+	// Optional
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"` // Optional. Inline keyboard attached to the message
+}
+
+// InlineQueryResultCachedPhoto https://core.telegram.org/bots/api/#inlinequeryresultcachedphoto
+type InlineQueryResultCachedPhoto struct {
+	ID          string `json:"id"`            // Unique identifier for this result, 1-64 bytes
+	PhotoFileID string `json:"photo_file_id"` // A valid file identifier of the photo
+
+	// Optional
+	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
+	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the photo to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the photo
+}
+
+// InlineQueryResultCachedGif https://core.telegram.org/bots/api/#inlinequeryresultcachedgif
+type InlineQueryResultCachedGif struct {
+	ID        string `json:"id"`          // Unique identifier for this result, 1-64 bytes
+	GifFileID string `json:"gif_file_id"` // A valid file identifier for the GIF file
+
+	// Optional
+	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the GIF file to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the GIF animation
+}
+
+// InlineQueryResultCachedMpeg4Gif https://core.telegram.org/bots/api/#inlinequeryresultcachedmpeg4gif
+type InlineQueryResultCachedMpeg4Gif struct {
+	ID          string `json:"id"`            // Unique identifier for this result, 1-64 bytes
+	Mpeg4FileID string `json:"mpeg4_file_id"` // A valid file identifier for the MP4 file
+
+	// Optional
+	Title               string                `json:"title,omitempty"`                 // Optional. Title for the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the MPEG-4 file to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video animation
+}
+
+// InlineQueryResultCachedSticker https://core.telegram.org/bots/api/#inlinequeryresultcachedsticker
+type InlineQueryResultCachedSticker struct {
+	ID            string `json:"id"`              // Unique identifier for this result, 1-64 bytes
+	StickerFileID string `json:"sticker_file_id"` // A valid file identifier of the sticker
+
+	// Optional
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the sticker
+}
+
+// InlineQueryResultCachedDocument https://core.telegram.org/bots/api/#inlinequeryresultcacheddocument
+type InlineQueryResultCachedDocument struct {
+	ID             string `json:"id"`               // Unique identifier for this result, 1-64 bytes
+	Title          string `json:"title"`            // Title for the result
+	DocumentFileID string `json:"document_file_id"` // A valid file identifier for the file
+
+	// Optional
+	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the document to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the file
+}
+
+// InlineQueryResultCachedVideo https://core.telegram.org/bots/api/#inlinequeryresultcachedvideo
+type InlineQueryResultCachedVideo struct {
+	ID          string `json:"id"`            // Unique identifier for this result, 1-64 bytes
+	VideoFileID string `json:"video_file_id"` // A valid file identifier for the video file
+	Title       string `json:"title"`         // Title for the result
+
+	// Optional
+	Description         string                `json:"description,omitempty"`           // Optional. Short description of the result
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption of the video to be sent, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the video
+}
+
+// InlineQueryResultCachedVoice https://core.telegram.org/bots/api/#inlinequeryresultcachedvoice
+type InlineQueryResultCachedVoice struct {
+	ID          string `json:"id"`            // Unique identifier for this result, 1-64 bytes
+	VoiceFileID string `json:"voice_file_id"` // A valid file identifier for the voice message
+	Title       string `json:"title"`         // Voice message title
+
+	// Optional
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the voice message
+}
+
+// InlineQueryResultCachedAudio https://core.telegram.org/bots/api/#inlinequeryresultcachedaudio
+type InlineQueryResultCachedAudio struct {
+	ID          string `json:"id"`            // Unique identifier for this result, 1-64 bytes
+	AudioFileID string `json:"audio_file_id"` // A valid file identifier for the audio file
+
+	// Optional
+	Caption             string                `json:"caption,omitempty"`               // Optional. Caption, 0-200 characters
+	ReplyMarkup         *InlineKeyboardMarkup `json:"reply_markup,omitempty"`          // Optional. Inline keyboard attached to the message
+	InputMessageContent interface{}           `json:"input_message_content,omitempty"` // Optional. Content of the message to be sent instead of the audio
+}
 
-// TODO: add more InlineQueryResult types
+// InputTextMessageContent https://core.telegram.org/bots/api/#inputtextmessagecontent
+type InputTextMessageContent struct {
+	MessageText string `json:"message_text"` // Text of the message to be sent, 1-4096 characters
+
+	// Optional
+	ParseMode             string `json:"parse_mode,omitempty"`               // Optional. Send Markdown or HTML, if you want Telegram apps to show bold, italic, fixed-width text or inline URLs in your bot's message.
+	DisableWebPagePreview bool   `json:"disable_web_page_preview,omitempty"` // Optional. Disables link previews for links in the sent message
+}
+
+// InputLocationMessageContent https://core.telegram.org/bots/api/#inputlocationmessagecontent
+type InputLocationMessageContent struct {
+	Latitude  float64 `json:"latitude"`  // Latitude of the location in degrees
+	Longitude float64 `json:"longitude"` // Longitude of the location in degrees
+}
+
+// InputVenueMessageContent https://core.telegram.org/bots/api/#inputvenuemessagecontent
+type InputVenueMessageContent struct {
+	Latitude  float64 `json:"latitude"`  // Latitude of the venue in degrees
+	Longitude float64 `json:"longitude"` // Longitude of the venue in degrees
+	Title     string  `json:"title"`     // Name of the venue
+	Address   string  `json:"address"`   // Address of the venue
+
+	// Optional
+	FoursquareID string `json:"foursquare_id,omitempty"` // Optional. Foursquare identifier of the venue, if known
+}
+
+// InputContactMessageContent https://core.telegram.org/bots/api/#inputcontactmessagecontent
+type InputContactMessageContent struct {
+	PhoneNumber string `json:"phone_number"` // Contact's phone number
+	FirstName   string `json:"first_name"`   // Contact's first name
+
+	// Optional
+	LastName string `json:"last_name,omitempty"` // Optional. Contact's last name
+}
 
 // ChosenInlineResult https://core.telegram.org/bots/api/#choseninlineresult
-type ChosenInlineResult Dummy
+type ChosenInlineResult struct {
+	ResultID string `json:"result_id"` // The unique identifier for the result that was chosen
+	From     User   `json:"from"`      // The user that chose the result
+
+	// Optional
+	Location        *Location `json:"location,omitempty"`          // Optional. Sender location, only for bots that require user location
+	InlineMessageID *string   `json:"inline_message_id,omitempty"` // Optional. Identifier of the sent inline message. Available only if there is an inline keyboard attached to the message. Will be also received in callback queries and can be used to edit the message.
+
+	Query string `json:"query"` // The query that was used to obtain the result
+}
 
 ///////////////////////////////////////////////////////////////////////////////
 // Payments Types
 ///////////////////////////////////////////////////////////////////////////////
 
 // LabeledPrice https://core.telegram.org/bots/api/#labeledprice
-type LabeledPrice Dummy
+type LabeledPrice struct {
+	Label  string  `json:"label"`  // Portion label
+	Amount Integer `json:"amount"` // Price of the product in the smallest units of the currency (integer, not float/double). For example, for a price of US$ 1.45 pass amount = 145.
+}
 
 // Invoice https://core.telegram.org/bots/api/#invoice
-type Invoice Dummy
+type Invoice struct {
+	Title          string  `json:"title"`           // Product name
+	Description    string  `json:"description"`     // Product description
+	StartParameter string  `json:"start_parameter"` // Unique bot deep-linking parameter that can be used to generate this invoice
+	Currency       string  `json:"currency"`        // Three-letter ISO 4217 currency code
+	TotalAmount    Integer `json:"total_amount"`    // Total price in the smallest units of the currency (integer, not float/double)
+}
 
 // ShippingAddress https://core.telegram.org/bots/api/#shippingaddress
-type ShippingAddress Dummy
+type ShippingAddress struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2 country code
+	State       string `json:"state"`        // State, if applicable
+	City        string `json:"city"`         // City
+	StreetLine1 string `json:"street_line1"` // First line for the address
+	StreetLine2 string `json:"street_line2"` // Second line for the address
+	PostCode    string `json:"post_code"`    // Address post code
+}
 
 // OrderInfo https://core.telegram.org/bots/api/#orderinfo
-type OrderInfo Dummy
+type OrderInfo struct {
+	// Optional
+	Name            *string          `json:"name,omitempty"`             // Optional. User name
+	PhoneNumber     *string          `json:"phone_number,omitempty"`     // Optional. User's phone number
+	Email           *string          `json:"email,omitempty"`            // Optional. User email
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"` // Optional. User shipping address
+}
 
 // ShippingOption https://core.telegram.org/bots/api/#shippingoption
-type ShippingOption Dummy
+type ShippingOption struct {
+	ID     string         `json:"id"`     // Shipping option identifier
+	Title  string         `json:"title"`  // Option title
+	Prices []LabeledPrice `json:"prices"` // List of price portions
+}
 
 // SuccessfulPayment https://core.telegram.org/bots/api/#successfulpayment
-type SuccessfulPayment Dummy
+type SuccessfulPayment struct {
+	Currency       string  `json:"currency"`        // Three-letter ISO 4217 currency code
+	TotalAmount    Integer `json:"total_amount"`    // Total price in the smallest units of the currency (integer, not float/double)
+	InvoicePayload string  `json:"invoice_payload"` // Bot specified invoice payload
+
+	// Optional
+	ShippingOptionID *string    `json:"shipping_option_id,omitempty"` // Optional. Identifier of the shipping option chosen by the user
+	OrderInfo        *OrderInfo `json:"order_info,omitempty"`         // Optional. Order info provided by the user
+
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"` // Telegram payment identifier
+	ProviderPaymentChargeID string `json:"provider_payment_charge_id"` // Provider payment identifier
+}
 
 // ShippingQuery https://core.telegram.org/bots/api/#shippingquery
-type ShippingQuery Dummy
+type ShippingQuery struct {
+	ID              string          `json:"id"`               // Unique query identifier
+	From            User            `json:"from"`             // User who sent the query
+	InvoicePayload  string          `json:"invoice_payload"`  // Bot specified invoice payload
+	ShippingAddress ShippingAddress `json:"shipping_address"` // User specified shipping address
+}
 
 // PreCheckoutQuery https://core.telegram.org/bots/api/#precheckoutquery
-type PreCheckoutQuery Dummy
+type PreCheckoutQuery struct {
+	ID             string  `json:"id"`              // Unique query identifier
+	From           User    `json:"from"`            // User who sent the query
+	Currency       string  `json:"currency"`        // Three-letter ISO 4217 currency code
+	TotalAmount    Integer `json:"total_amount"`    // Total price in the smallest units of the currency (integer, not float/double)
+	InvoicePayload string  `json:"invoice_payload"` // Bot specified invoice payload
+
+	// Optional
+	ShippingOptionID *string    `json:"shipping_option_id,omitempty"` // Optional. Identifier of the shipping option chosen by the user
+	OrderInfo        *OrderInfo `json:"order_info,omitempty"`         // Optional. Order info provided by the user
+}
 
 ///////////////////////////////////////////////////////////////////////////////
 // Games Types
@@ -434,7 +853,7 @@ type Animation struct {
 }
 
 // CallbackGame https://core.telegram.org/bots/api/#callbackgame
-type CallbackGame Placeholder // A placeholder, currently holds no information. Use BotFather to set up your game.
+type CallbackGame struct{} // A placeholder, currently holds no information. Use BotFather to set up your game.
 
 // GameHighScore https://core.telegram.org/bots/api/#gamehighscore
 type GameHighScore struct {
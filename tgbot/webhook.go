@@ -0,0 +1,106 @@
+package tgbot
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"sync"
+)
+
+// DefaultWebhookMaxBodySize max size of update request body accepted by WebhookHandler by default
+const DefaultWebhookMaxBodySize int64 = 1 << 20
+
+// WebhookHandler http.Handler receiving updates from Telegram webhook https://core.telegram.org/bots/api#setwebhook
+// Updates are dispatched to the same Handler as used with Bot.PollUpdatesHandler.
+type WebhookHandler struct {
+	Handler Handler // Handler of received updates
+
+	// Optional
+	SecretToken string          // Optional. Requests without matching X-Telegram-Bot-Api-Secret-Token header are rejected with 401 if set
+	MaxBodySize int64           // Optional. Max request body size, DefaultWebhookMaxBodySize if 0
+	Async       bool            // Optional. Respond 200 right away and handle updates in background, see Wait
+	Context     context.Context // Optional. Context of updates handled in background, context.Background() if nil
+	Logger      *log.Logger     // Optional. Rejected requests, handler errors and panics are logged here if set
+
+	wg sync.WaitGroup
+}
+
+// NewWebhookHandler create WebhookHandler passing updates to handler
+func NewWebhookHandler(handler Handler) *WebhookHandler {
+	return &WebhookHandler{Handler: handler}
+}
+
+// ServeHTTP implements http.Handler
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		wh.reject(w, http.StatusMethodNotAllowed, "method "+r.Method+" is not allowed")
+		return
+	}
+
+	if len(wh.SecretToken) > 0 {
+		token := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(wh.SecretToken)) != 1 {
+			wh.reject(w, http.StatusUnauthorized, "secret token mismatch")
+			return
+		}
+	}
+
+	maxBodySize := wh.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultWebhookMaxBodySize
+	}
+
+	update := &Update{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(update); err != nil {
+		wh.reject(w, http.StatusBadRequest, "can't decode update: "+err.Error())
+		return
+	}
+
+	if !wh.Async {
+		wh.handle(r.Context(), update)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	ctx := wh.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	wh.wg.Add(1)
+	go func() {
+		defer wh.wg.Done()
+		wh.handle(ctx, update)
+	}()
+	w.WriteHeader(http.StatusOK)
+}
+
+// Wait waits for updates handled in background to finish
+func (wh *WebhookHandler) Wait() {
+	wh.wg.Wait()
+}
+
+func (wh *WebhookHandler) handle(ctx context.Context, update *Update) {
+	defer func() {
+		if r := recover(); r != nil {
+			wh.logf("tgbot.WebhookHandler: update %v handler panicked: %v\n%s", update.UpdateID, r, debug.Stack())
+		}
+	}()
+
+	if err := wh.Handler.HandleUpdate(ctx, update); err != nil {
+		wh.logf("tgbot.WebhookHandler: update %v handler failed: %v", update.UpdateID, err)
+	}
+}
+
+func (wh *WebhookHandler) reject(w http.ResponseWriter, status int, reason string) {
+	wh.logf("tgbot.WebhookHandler: rejected request: %v", reason)
+	http.Error(w, http.StatusText(status), status)
+}
+
+func (wh *WebhookHandler) logf(format string, v ...interface{}) {
+	if wh.Logger != nil {
+		wh.Logger.Printf(format, v...)
+	}
+}
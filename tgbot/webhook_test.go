@@ -0,0 +1,106 @@
+package tgbot
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func postTestUpdate(handler http.Handler, secretToken string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if len(secretToken) > 0 {
+		r.Header.Set("X-Telegram-Bot-Api-Secret-Token", secretToken)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestWebhookHandler(t *testing.T) {
+	var received []*Update
+	wh := NewWebhookHandler(HandlerFunc(func(ctx context.Context, update *Update) error {
+		received = append(received, update)
+		return nil
+	}))
+	wh.SecretToken = "s3cret"
+
+	body := `{"update_id":100,"message":{"message_id":1,"date":0,"chat":{"id":42,"type":"private"},"text":"hi"}}`
+	if w := postTestUpdate(wh, "s3cret", body); w.Code != 200 {
+		t.Fatalf("Unexpected status: %v", w.Code)
+	}
+
+	if len(received) != 1 || received[0].UpdateID != 100 || *received[0].Message.Text != "hi" {
+		t.Fatalf("Unexpected updates: %v", received)
+	}
+
+	if w := postTestUpdate(wh, "wrong", body); w.Code != http.StatusUnauthorized {
+		t.Fatalf("Request with wrong secret token should be rejected, got %v", w.Code)
+	}
+
+	if w := postTestUpdate(wh, "s3cret", `{"update_id":`); w.Code != http.StatusBadRequest {
+		t.Fatalf("Malformed update should be rejected, got %v", w.Code)
+	}
+
+	wh.MaxBodySize = 16
+	if w := postTestUpdate(wh, "s3cret", body); w.Code != http.StatusBadRequest {
+		t.Fatalf("Too large update should be rejected, got %v", w.Code)
+	}
+
+	if len(received) != 1 {
+		t.Fatalf("Rejected updates should not be handled: %v", received)
+	}
+}
+
+func TestWebhookHandlerAsync(t *testing.T) {
+	release := make(chan struct{})
+	handled := make(chan Integer, 1)
+	wh := NewWebhookHandler(HandlerFunc(func(ctx context.Context, update *Update) error {
+		<-release
+		handled <- update.UpdateID
+		return nil
+	}))
+	wh.Async = true
+
+	server := httptest.NewServer(wh)
+	defer server.Close()
+
+	response, err := http.Post(server.URL, "application/json", strings.NewReader(`{"update_id":7}`))
+	if err != nil || response.StatusCode != 200 {
+		t.Fatalf("Webhook request failed: %v", err)
+	}
+	response.Body.Close()
+
+	// responded before handling
+	close(release)
+	wh.Wait()
+	if updateID := <-handled; updateID != 7 {
+		t.Fatalf("Unexpected update: %v", updateID)
+	}
+
+	response, _ = http.Get(server.URL)
+	if response.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("GET should not be allowed, got %v", response.StatusCode)
+	}
+}
+
+func TestWebhookHandlerAsyncPanic(t *testing.T) {
+	var logged bytes.Buffer
+	wh := NewWebhookHandler(HandlerFunc(func(ctx context.Context, update *Update) error {
+		panic("handler bug")
+	}))
+	wh.Async = true
+	wh.Logger = log.New(&logged, "", 0)
+
+	if w := postTestUpdate(wh, "", `{"update_id":7}`); w.Code != 200 {
+		t.Fatalf("Unexpected status %v", w.Code)
+	}
+	wh.Wait()
+	if !strings.Contains(logged.String(), "update 7 handler panicked: handler bug") {
+		t.Fatalf("Panic is not logged: %q", logged.String())
+	}
+}
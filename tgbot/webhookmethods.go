@@ -0,0 +1,113 @@
+package tgbot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SetWebhookParams https://core.telegram.org/bots/api#setwebhook
+type SetWebhookParams struct {
+	URL string `json:"url"` // HTTPS url to send updates to. Use an empty string to remove webhook integration
+
+	// Optional
+	Certificate        *InputFile `json:"certificate,omitempty"`          // Optional. Upload your public key certificate so that the root certificate in use can be checked
+	MaxConnections     Integer    `json:"max_connections,omitempty"`      // Optional. Maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery, 1-100. Defaults to 40
+	AllowedUpdates     []string   `json:"allowed_updates,omitempty"`      // Optional. List the types of updates you want your bot to receive, e.g. ["message", "callback_query"]
+	SecretToken        string     `json:"secret_token,omitempty"`         // Optional. Sent in X-Telegram-Bot-Api-Secret-Token header of every webhook request, see WebhookHandler.SecretToken
+	DropPendingUpdates bool       `json:"drop_pending_updates,omitempty"` // Optional. Pass True to drop all pending updates
+}
+
+// callBool call method that returns True on success
+func (bot *Bot) callBool(ctx context.Context, methodName string, params Params) (bool, int, error) {
+	response, status, err := bot.Call(ctx, methodName, params)
+	if err != nil {
+		return false, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	result, err := response.GetResultBool()
+	if err != nil {
+		return false, status, fmt.Errorf("tgbot.%v: %w", methodName, err)
+	}
+
+	return result, status, nil
+}
+
+// SetWebhook https://core.telegram.org/bots/api#setwebhook
+func (bot *Bot) SetWebhook(ctx context.Context, params SetWebhookParams) (bool, int, error) {
+	return bot.callBool(ctx, "setWebhook", paramsOf(params))
+}
+
+// DeleteWebhook https://core.telegram.org/bots/api#deletewebhook
+func (bot *Bot) DeleteWebhook(ctx context.Context, dropPendingUpdates bool) (bool, int, error) {
+	params := Params{}
+	if dropPendingUpdates {
+		params["drop_pending_updates"] = true
+	}
+	return bot.callBool(ctx, "deleteWebhook", params)
+}
+
+// GetWebhookInfo https://core.telegram.org/bots/api#getwebhookinfo
+func (bot *Bot) GetWebhookInfo(ctx context.Context) (*WebhookInfo, int, error) {
+	response, status, err := bot.Get(ctx, "getWebhookInfo", Params{})
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.GetWebhookInfo: %w", err)
+	}
+
+	webhookInfo, err := response.GetResultWebhookInfo()
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.GetWebhookInfo: %w", err)
+	}
+
+	return webhookInfo, status, nil
+}
+
+// UseWebhook switch bot from polling to webhook mode. Stop polling before the call, getUpdates fails while webhook is set.
+// If offset is positive, updates before it are confirmed with getUpdates first, so they are not delivered to webhook again
+// (use the offset returned by Bot.PollUpdatesCB). Webhook is verified with getWebhookInfo.
+func (bot *Bot) UseWebhook(ctx context.Context, offset Integer, params SetWebhookParams) (*WebhookInfo, int, error) {
+	if len(params.URL) == 0 {
+		return nil, 0, errors.New("tgbot.UseWebhook: empty webhook URL, use UsePolling to remove webhook")
+	}
+
+	if offset > 0 {
+		_, status, err := bot.GetUpdates(ctx, Params{"offset": offset, "limit": 1, "timeout": 0})
+		if err != nil {
+			return nil, status, fmt.Errorf("tgbot.UseWebhook: confirm updates: %w", err)
+		}
+	}
+
+	if _, status, err := bot.SetWebhook(ctx, params); err != nil {
+		return nil, status, fmt.Errorf("tgbot.UseWebhook: %w", err)
+	}
+
+	webhookInfo, status, err := bot.GetWebhookInfo(ctx)
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.UseWebhook: %w", err)
+	}
+
+	if webhookInfo.URL != params.URL {
+		return webhookInfo, status, errors.New("tgbot.UseWebhook: webhook URL is " + webhookInfo.URL + " after setWebhook")
+	}
+
+	return webhookInfo, status, nil
+}
+
+// UsePolling switch bot from webhook to polling mode: delete webhook and verify it's removed with getWebhookInfo.
+// Pending updates are kept for getUpdates unless dropPendingUpdates is set.
+func (bot *Bot) UsePolling(ctx context.Context, dropPendingUpdates bool) (*WebhookInfo, int, error) {
+	if _, status, err := bot.DeleteWebhook(ctx, dropPendingUpdates); err != nil {
+		return nil, status, fmt.Errorf("tgbot.UsePolling: %w", err)
+	}
+
+	webhookInfo, status, err := bot.GetWebhookInfo(ctx)
+	if err != nil {
+		return nil, status, fmt.Errorf("tgbot.UsePolling: %w", err)
+	}
+
+	if len(webhookInfo.URL) > 0 {
+		return webhookInfo, status, errors.New("tgbot.UsePolling: webhook " + webhookInfo.URL + " is still set")
+	}
+
+	return webhookInfo, status, nil
+}
@@ -0,0 +1,101 @@
+package tgbot
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// fakeWebhookAPI Bot API server keeping webhook URL
+type fakeWebhookAPI struct {
+	t       *testing.T
+	url     string
+	methods []string
+}
+
+func (api *fakeWebhookAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	methodName := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	api.methods = append(api.methods, methodName)
+
+	switch methodName {
+	case "setWebhook":
+		file, _, err := r.FormFile("certificate")
+		if err != nil {
+			api.t.Errorf("No certificate uploaded: %v", err)
+		} else if content, _ := ioutil.ReadAll(file); string(content) != "PEM" {
+			api.t.Errorf("Unexpected certificate: %s", content)
+		}
+		if r.FormValue("allowed_updates") != `["message","callback_query"]` || r.FormValue("secret_token") != "s3cret" ||
+			r.FormValue("max_connections") != "10" || r.FormValue("drop_pending_updates") != "" {
+			api.t.Errorf("Unexpected setWebhook form: %v", r.Form)
+		}
+		api.url = r.FormValue("url")
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	case "deleteWebhook":
+		if r.FormValue("drop_pending_updates") != "true" {
+			api.t.Errorf("Unexpected deleteWebhook form: %v", r.Form)
+		}
+		api.url = ""
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	case "getWebhookInfo":
+		writeTestResponse(w, 200, `{"ok":true,"result":{"url":"`+api.url+`","has_custom_certificate":true,`+
+			`"pending_update_count":3,"last_error_date":1500000000,"last_error_message":"Connection refused"}}`)
+	case "getUpdates":
+		if r.URL.Query().Get("offset") != "15" {
+			api.t.Errorf("Unexpected getUpdates offset: %v", r.URL.Query().Get("offset"))
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":[]}`)
+	default:
+		writeTestResponse(w, 404, `{"ok":false,"error_code":404,"description":"Not Found"}`)
+	}
+}
+
+func TestBotUseWebhookAndPolling(t *testing.T) {
+	api := &fakeWebhookAPI{t: t}
+	bot := newTestBot(t, api.ServeHTTP)
+
+	webhookInfo, _, err := bot.UseWebhook(context.Background(), 15, SetWebhookParams{
+		URL:            "https://example.com/webhook",
+		Certificate:    FileBytes("cert.pem", []byte("PEM")),
+		MaxConnections: 10,
+		AllowedUpdates: []string{"message", "callback_query"},
+		SecretToken:    "s3cret",
+	})
+	if err != nil {
+		t.Fatal("bot.UseWebhook() failed: " + err.Error())
+	}
+
+	if webhookInfo.URL != "https://example.com/webhook" || webhookInfo.PendingUpdateCount != 3 ||
+		*webhookInfo.LastErrorDate != 1500000000 || *webhookInfo.LastErrorMessage != "Connection refused" {
+		t.Fatalf("Unexpected webhook info: %+v", webhookInfo)
+	}
+
+	if webhookInfo, _, err = bot.UsePolling(context.Background(), true); err != nil || webhookInfo.URL != "" {
+		t.Fatalf("bot.UsePolling() failed: %v", err)
+	}
+
+	expected := "getUpdates setWebhook getWebhookInfo deleteWebhook getWebhookInfo"
+	if methods := strings.Join(api.methods, " "); methods != expected {
+		t.Fatal("Unexpected methods called: " + methods)
+	}
+}
+
+func TestBotUseWebhookNotSet(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/getWebhookInfo") {
+			writeTestResponse(w, 200, `{"ok":true,"result":{"url":"","has_custom_certificate":false,"pending_update_count":0}}`)
+			return
+		}
+		writeTestResponse(w, 200, `{"ok":true,"result":true}`)
+	})
+
+	if _, _, err := bot.UseWebhook(context.Background(), 0, SetWebhookParams{URL: "https://example.com/webhook"}); err == nil {
+		t.Fatal("bot.UseWebhook() should've failed when webhook is not set")
+	}
+
+	if _, _, err := bot.UseWebhook(context.Background(), 0, SetWebhookParams{}); err == nil {
+		t.Fatal("bot.UseWebhook() should've failed with empty URL")
+	}
+}
@@ -0,0 +1,151 @@
+package tgbot
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"sync"
+)
+
+// UpdateKeyFunc ordering key of update: updates with the same key are handled one by one in order
+type UpdateKeyFunc func(update *Update) string
+
+// ChatKey UpdateKeyFunc keying updates by chat of the message, or by sender of queries.
+// Updates without known chat or sender get unique keys.
+func ChatKey(update *Update) string {
+	if chat := update.Chat(); chat != nil {
+		return strconv.FormatInt(int64(chat.ID), 10)
+	}
+	if sender := update.Sender(); sender != nil {
+		return strconv.FormatInt(int64(sender.ID), 10)
+	}
+	return "update:" + strconv.FormatInt(int64(update.UpdateID), 10)
+}
+
+// WorkerPool handles updates concurrently across chats, but strictly in order within a chat (see Key).
+// Updates of the same key go to the same worker, each worker has a bounded queue:
+// HandleUpdates blocks while the queue is full. Handler panics are recovered and logged.
+// WorkerPool created without NewWorkerPool gets runtime.NumCPU() workers with DefaultWorkerPoolQueueSize queues.
+type WorkerPool struct {
+	Handler Handler       // Handler of updates
+	Key     UpdateKeyFunc // Ordering key of updates, ChatKey if nil
+
+	// Optional
+	Logger *log.Logger // Optional. Handler errors and panics are logged here if set
+
+	queues []chan poolTask
+	once   sync.Once
+	wg     sync.WaitGroup
+}
+
+type poolTask struct {
+	ctx    context.Context
+	update *Update
+	done   func()
+}
+
+// DefaultWorkerPoolQueueSize queue size of each worker of WorkerPool created without NewWorkerPool
+const DefaultWorkerPoolQueueSize = 100
+
+// NewWorkerPool create WorkerPool with workers goroutines, each with queue of queueSize updates
+func NewWorkerPool(handler Handler, workers int, queueSize int) *WorkerPool {
+	return &WorkerPool{Handler: handler, queues: makePoolQueues(workers, queueSize)}
+}
+
+func makePoolQueues(workers int, queueSize int) []chan poolTask {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	queues := make([]chan poolTask, workers)
+	for i := range queues {
+		queues[i] = make(chan poolTask, queueSize)
+	}
+	return queues
+}
+
+// start workers on first use
+func (pool *WorkerPool) start() {
+	pool.once.Do(func() {
+		if len(pool.queues) == 0 {
+			pool.queues = makePoolQueues(runtime.NumCPU(), DefaultWorkerPoolQueueSize)
+		}
+		for _, queue := range pool.queues {
+			pool.wg.Add(1)
+			go pool.work(queue)
+		}
+	})
+}
+
+func (pool *WorkerPool) work(queue <-chan poolTask) {
+	defer pool.wg.Done()
+	for task := range queue {
+		pool.handle(task)
+	}
+}
+
+func (pool *WorkerPool) handle(task poolTask) {
+	defer task.done()
+	defer func() {
+		if r := recover(); r != nil {
+			pool.logf("tgbot.WorkerPool: update %v handler panicked: %v\n%s", task.update.UpdateID, r, debug.Stack())
+		}
+	}()
+
+	if err := pool.Handler.HandleUpdate(task.ctx, task.update); err != nil {
+		pool.logf("tgbot.WorkerPool: update %v handler failed: %v", task.update.UpdateID, err)
+	}
+}
+
+// HandleUpdates queue updates to workers and wait until all of them are handled.
+// If ctx is done before all updates are queued, waits for queued ones and returns ctx.Err().
+func (pool *WorkerPool) HandleUpdates(ctx context.Context, updates []Update) error {
+	pool.start()
+
+	key := pool.Key
+	if key == nil {
+		key = ChatKey
+	}
+
+	var batch sync.WaitGroup
+	defer batch.Wait()
+	for i := range updates {
+		queue := pool.queues[pool.worker(key(&updates[i]))]
+		batch.Add(1)
+		select {
+		case queue <- poolTask{ctx: ctx, update: &updates[i], done: batch.Done}:
+		case <-ctx.Done():
+			batch.Done()
+			return fmt.Errorf("tgbot.WorkerPool.HandleUpdates: %w", ctx.Err())
+		}
+	}
+	return nil
+}
+
+// Close stop workers after queued updates are handled. HandleUpdates must not be called after Close.
+func (pool *WorkerPool) Close() {
+	pool.start()
+	for _, queue := range pool.queues {
+		close(queue)
+	}
+	pool.wg.Wait()
+}
+
+func (pool *WorkerPool) worker(key string) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	return int(hash.Sum32() % uint32(len(pool.queues)))
+}
+
+func (pool *WorkerPool) logf(format string, v ...interface{}) {
+	if pool.Logger != nil {
+		pool.Logger.Printf(format, v...)
+	}
+}
@@ -0,0 +1,162 @@
+package tgbot
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestChatUpdates(chats int, perChat int) []Update {
+	var updates []Update
+	for i := 0; i < perChat; i++ {
+		for chat := 1; chat <= chats; chat++ {
+			text := strconv.Itoa(i)
+			updates = append(updates, Update{
+				UpdateID: Integer(len(updates) + 1),
+				Message:  &Message{Chat: Chat{ID: Integer(chat)}, Text: &text},
+			})
+		}
+	}
+	return updates
+}
+
+func TestWorkerPoolPerChatOrder(t *testing.T) {
+	var mutex sync.Mutex
+	handled := map[Integer][]string{}
+	var running, maxRunning int32
+	pool := NewWorkerPool(HandlerFunc(func(ctx context.Context, update *Update) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		chatID := update.Message.Chat.ID
+		handled[chatID] = append(handled[chatID], *update.Message.Text)
+		return nil
+	}), 4, 2)
+	defer pool.Close()
+
+	if err := pool.HandleUpdates(context.Background(), newTestChatUpdates(8, 10)); err != nil {
+		t.Fatal("pool.HandleUpdates() failed: " + err.Error())
+	}
+
+	for chatID := Integer(1); chatID <= 8; chatID++ {
+		if len(handled[chatID]) != 10 {
+			t.Fatalf("Chat %v: not all updates handled: %v", chatID, handled[chatID])
+		}
+		for i, text := range handled[chatID] {
+			if text != strconv.Itoa(i) {
+				t.Fatalf("Chat %v: updates handled out of order: %v", chatID, handled[chatID])
+			}
+		}
+	}
+
+	if atomic.LoadInt32(&maxRunning) < 2 {
+		t.Fatal("Updates of different chats should be handled concurrently")
+	}
+}
+
+func TestWorkerPoolPanic(t *testing.T) {
+	var handled int32
+	pool := NewWorkerPool(HandlerFunc(func(ctx context.Context, update *Update) error {
+		atomic.AddInt32(&handled, 1)
+		if update.UpdateID == 2 {
+			panic("handler bug")
+		}
+		return nil
+	}), 1, 1)
+	defer pool.Close()
+
+	if err := pool.HandleUpdates(context.Background(), newTestChatUpdates(1, 3)); err != nil {
+		t.Fatal("pool.HandleUpdates() failed: " + err.Error())
+	}
+
+	if atomic.LoadInt32(&handled) != 3 {
+		t.Fatalf("Panic should not stop the worker, handled %v updates", handled)
+	}
+}
+
+func TestWorkerPoolLiteral(t *testing.T) {
+	var handled int32
+	pool := &WorkerPool{Handler: HandlerFunc(func(ctx context.Context, update *Update) error {
+		atomic.AddInt32(&handled, 1)
+		return nil
+	})}
+	defer pool.Close()
+
+	if err := pool.HandleUpdates(context.Background(), newTestChatUpdates(3, 2)); err != nil {
+		t.Fatal("pool.HandleUpdates() failed: " + err.Error())
+	}
+	if atomic.LoadInt32(&handled) != 6 {
+		t.Fatalf("Not all updates handled: %v", handled)
+	}
+}
+
+func TestWorkerPoolBackpressure(t *testing.T) {
+	release := make(chan struct{})
+	pool := NewWorkerPool(HandlerFunc(func(ctx context.Context, update *Update) error {
+		<-release
+		return nil
+	}), 1, 1)
+	defer pool.Close()
+	defer close(release)
+
+	// one update is handled, one is queued, the third can't be queued
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- pool.HandleUpdates(ctx, newTestChatUpdates(1, 3)) }()
+
+	select {
+	case <-done:
+		t.Fatal("pool.HandleUpdates() should block while updates are handled")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	release <- struct{}{}
+	release <- struct{}{}
+	if err := <-done; err == nil {
+		t.Fatal("pool.HandleUpdates() should've failed when ctx is done before updates are queued")
+	}
+}
+
+func TestBotPollUpdatesPool(t *testing.T) {
+	bot := newTestBot(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			writeTestResponse(w, 200, `{"ok":true,"result":[{"update_id":1},{"update_id":2},{"update_id":3}]}`)
+			return
+		}
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var handled int32
+	pool := NewWorkerPool(HandlerFunc(func(ctx context.Context, update *Update) error {
+		if atomic.AddInt32(&handled, 1) == 3 {
+			cancel()
+		}
+		return nil
+	}), 2, 1)
+	defer pool.Close()
+
+	offset, _, err := bot.PollUpdatesPool(ctx, Params{}, pool)
+	if err != context.Canceled {
+		t.Fatalf("bot.PollUpdatesPool() should've been cancelled, got %v", err)
+	}
+
+	// the whole batch was handled, though ctx was cancelled during it
+	if offset != 4 || atomic.LoadInt32(&handled) != 3 {
+		t.Fatalf("Unexpected offset %v after %v updates handled", offset, handled)
+	}
+}